@@ -1,7 +1,15 @@
 package main
 
 import (
+	"context"
+	"fmt"
+	"sync"
+
 	"github.com/noah-blockchain/noah-explorer-api/internal/api"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/addresses"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/coins"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/statistics"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/status"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core"
 	"github.com/noah-blockchain/noah-explorer-api/internal/database"
 )
@@ -12,11 +20,55 @@ func main() {
 
 	// connect to database
 	db := database.Connect(env)
-	defer database.Close(db)
 
 	// create explorer
 	explorer := core.NewExplorer(db, env)
 
-	// run api
+	// create tables and views before serving requests, the first creation of rankings computes them
+	if err := explorer.Migrate(); err != nil {
+		panic(fmt.Sprintf("Could not migrate database: %s", err))
+	}
+
+	// background workers stop on cancel, wait for them before closing database
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	start := func(worker func()) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			worker()
+		}()
+	}
+
+	// watch new blocks for the stream subscribers
+	start(func() { explorer.Feed.Run(ctx) })
+
+	// drop expired cache items
+	start(func() { explorer.Cache.RunExpirationCheck(ctx) })
+
+	// update market price of the base coin
+	start(func() { explorer.Market.Run(ctx) })
+
+	// publish chain health metrics
+	start(func() { status.CollectChainMetrics(ctx, explorer) })
+
+	// keep daily history of decentralization metrics
+	start(func() { statistics.CollectDecentralizationSnapshots(ctx, explorer) })
+
+	// keep price candles of coins up to date
+	start(func() { coins.CollectCandles(ctx, explorer) })
+
+	// keep daily history of coin holders counts
+	start(func() { coins.CollectHolderSnapshots(ctx, explorer) })
+
+	// keep rankings of addresses up to date
+	start(func() { addresses.RefreshRichList(ctx, explorer) })
+
+	// run api until shutdown signal
 	api.Run(db, explorer)
+
+	// stop background workers and close database after requests and running jobs are drained
+	cancel()
+	wg.Wait()
+	database.Close(db)
 }
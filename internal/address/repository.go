@@ -1,9 +1,10 @@
 package address
 
 import (
+	"fmt"
+
 	"github.com/go-pg/pg"
 	"github.com/noah-blockchain/coinExplorer-tools/models"
-	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
 )
 
@@ -17,59 +18,47 @@ func NewRepository(db *pg.DB) *Repository {
 	}
 }
 
-//Get paginated list of addresses
-func (repository Repository) GetPaginatedAddresses(pagination *tools.Pagination) []models.Address {
-	var addresses []models.Address
-	var err error
-
-	pagination.Total, err = repository.DB.Model(&addresses).
-		Column("Balances", "Balances.Coin").
-		Apply(pagination.Filter).
-		SelectAndCount()
-
-	helpers.CheckErr(err)
-
-	return addresses
-}
-
 // Get address model by address
-func (repository Repository) GetByAddress(noahAddress string) *models.Address {
+func (repository Repository) GetByAddress(noahAddress string) (*models.Address, error) {
 	var address models.Address
 
 	err := repository.DB.Model(&address).Column("Balances", "Balances.Coin").
 		Where("address = ?", noahAddress).Select()
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	return &address
+	return &address, nil
 }
 
 // Get list of addresses models
-func (repository Repository) GetByAddresses(noahAddresses []string) []models.Address {
+func (repository Repository) GetByAddresses(noahAddresses []string) ([]models.Address, error) {
 	var addresses []models.Address
 
 	err := repository.DB.Model(&addresses).Column("Balances", "Balances.Coin").
 		WhereIn("address IN (?)", pg.In(noahAddresses)).Select()
 
-	helpers.CheckErr(err)
-
-	return addresses
+	return addresses, err
 }
 
 // Get address model by address
-func (repository Repository) GetBalancesByCoinSymbol(coinSymbol string, pagination *tools.Pagination) []models.Balance {
+func (repository Repository) GetBalancesByCoinSymbol(coinSymbol string, orderBy *string, pagination *tools.Pagination) ([]models.Balance, error) {
 	var balances []models.Balance
 	var err error
 
-	pagination.Total, err = repository.DB.Model(&balances).
+	query := repository.DB.Model(&balances).
 		Join("LEFT JOIN coins as c").
 		JoinOn("balance.coin_id = c.id").
 		Where("c.symbol=?", coinSymbol).
 		Column("Address.address", "balance.value").
-		Apply(pagination.Filter).
-		SelectAndCount()
+		Apply(pagination.Filter)
+
+	// order by amount, addresses with equal amounts keep a stable order between pages
+	if orderBy != nil {
+		query = query.OrderExpr(fmt.Sprintf("balance.value %s, balance.address_id %s", *orderBy, *orderBy))
+	}
+
+	pagination.Total, err = query.SelectAndCount()
 
-	helpers.CheckErr(err)
-	return balances
+	return balances, err
 }
@@ -0,0 +1,153 @@
+package timeline
+
+import (
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+)
+
+// Transaction types of address activity
+var TxTypes = []uint8{
+	models.TxTypeSend,
+	models.TxTypeMultiSend,
+	models.TxTypeSellCoin,
+	models.TxTypeSellAllCoin,
+	models.TxTypeBuyCoin,
+	models.TxTypeDelegate,
+	models.TxTypeUnbound,
+	models.TxTypeRedeemCheck,
+}
+
+type Repository struct {
+	db *pg.DB
+}
+
+func NewRepository(db *pg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Rewards of address at the block summed by validators and roles
+type Reward struct {
+	BlockID   uint64
+	CreatedAt time.Time
+	Amount    string
+	Count     uint64
+}
+
+// Return of unbonded stake to address at the end of the unbond period
+type UnbondReturn struct {
+	BlockID   uint64
+	CreatedAt time.Time
+	Hash      string
+	Data      models.UnbondTxData
+}
+
+// Get the latest activity transactions of address before the block,
+// at least limit rows and all rows of the block of the last one
+func (repository Repository) GetTransactions(address string, beforeBlock *uint64, limit int) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	filter := func(query *orm.Query) (*orm.Query, error) {
+		query = query.Where("transaction.id IN (SELECT transaction_id FROM index_transaction_by_address WHERE address_id = (SELECT id FROM addresses WHERE address = ?))", address).
+			Where("transaction.type IN (?)", pg.In(TxTypes))
+
+		if beforeBlock != nil {
+			query = query.Where("transaction.block_id < ?", *beforeBlock)
+		}
+
+		return query, nil
+	}
+
+	lastBlock := repository.db.Model((*models.Transaction)(nil)).
+		Column("transaction.block_id").
+		Apply(filter).
+		Order("transaction.block_id DESC", "transaction.id DESC").
+		Offset(limit - 1).
+		Limit(1)
+
+	err := repository.db.Model(&transactions).
+		Column("transaction.*", "FromAddress.address", "GasCoin.symbol").
+		Apply(filter).
+		Where("transaction.block_id >= COALESCE((?), 0)", lastBlock).
+		Order("transaction.block_id DESC", "transaction.id DESC").
+		Select()
+
+	return transactions, err
+}
+
+// Get the latest rewards of address before the block, summed by blocks, one per block
+func (repository Repository) GetRewards(address string, beforeBlock *uint64, limit int) ([]Reward, error) {
+	var rewards []Reward
+
+	_, err := repository.db.Query(&rewards, `
+		SELECT r.block_id, r.created_at, SUM(r.amount) AS amount, COUNT(*) AS count
+		FROM rewards AS r
+		WHERE r.address_id = (SELECT id FROM addresses WHERE address = ?0)
+			AND (?1::bigint IS NULL OR r.block_id < ?1)
+		GROUP BY r.block_id, r.created_at
+		ORDER BY r.block_id DESC
+		LIMIT ?2`,
+		address, beforeBlock, limit)
+
+	return rewards, err
+}
+
+// Get the latest slashes of address stakes before the block,
+// at least limit rows and all rows of the block of the last one
+func (repository Repository) GetSlashes(address string, beforeBlock *uint64, limit int) ([]models.Slash, error) {
+	var slashes []models.Slash
+
+	filter := func(query *orm.Query) (*orm.Query, error) {
+		query = query.Where("slash.address_id = (SELECT id FROM addresses WHERE address = ?)", address)
+
+		if beforeBlock != nil {
+			query = query.Where("slash.block_id < ?", *beforeBlock)
+		}
+
+		return query, nil
+	}
+
+	lastBlock := repository.db.Model((*models.Slash)(nil)).
+		Column("slash.block_id").
+		Apply(filter).
+		Order("slash.block_id DESC", "slash.id DESC").
+		Offset(limit - 1).
+		Limit(1)
+
+	err := repository.db.Model(&slashes).
+		Column("Coin.symbol", "Address.address", "Validator.public_key", "Block.created_at").
+		Column("Validator.name", "Validator.description", "Validator.icon_url", "Validator.site_url").
+		Apply(filter).
+		Where("slash.block_id >= COALESCE((?), 0)", lastBlock).
+		Order("slash.block_id DESC", "slash.id DESC").
+		Select()
+
+	return slashes, err
+}
+
+// Get the latest returns of unbonded stakes to address before the block,
+// at least limit rows and all rows of the block of the last one
+func (repository Repository) GetUnbondReturns(address string, beforeBlock *uint64, limit int) ([]UnbondReturn, error) {
+	var returns []UnbondReturn
+
+	_, err := repository.db.Query(&returns, `
+		WITH unbonds AS (
+			SELECT b.id AS block_id, b.created_at, t.id, t.hash, t.data
+			FROM transactions AS t
+			INNER JOIN blocks AS b ON b.id = t.block_id + ?1
+			WHERE t.from_address_id = (SELECT id FROM addresses WHERE address = ?0)
+				AND t.type = ?2
+				AND (?3::bigint IS NULL OR b.id < ?3)
+		)
+		SELECT block_id, created_at, hash, data FROM unbonds
+		WHERE block_id >= COALESCE((SELECT block_id FROM unbonds ORDER BY block_id DESC, id DESC OFFSET ?4 LIMIT 1), 0)
+		ORDER BY block_id DESC, id DESC`,
+		address, config.UnbondPeriodInBlocks, models.TxTypeUnbound, beforeBlock, limit-1)
+
+	return returns, err
+}
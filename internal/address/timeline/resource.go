@@ -0,0 +1,184 @@
+package timeline
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/balance"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+	"github.com/noah-blockchain/noah-explorer-api/internal/slash"
+	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
+)
+
+const (
+	TypeTransferIn   = "transfer_in"
+	TypeTransferOut  = "transfer_out"
+	TypeConversion   = "conversion"
+	TypeDelegate     = "delegate"
+	TypeUnbond       = "unbond"
+	TypeUnbondReturn = "unbond_return"
+	TypeRedeemCheck  = "redeem_check"
+	TypeReward       = "reward"
+	TypeSlash        = "slash"
+)
+
+var txEntryTypes = map[uint8]string{
+	models.TxTypeSellCoin:    TypeConversion,
+	models.TxTypeSellAllCoin: TypeConversion,
+	models.TxTypeBuyCoin:     TypeConversion,
+	models.TxTypeDelegate:    TypeDelegate,
+	models.TxTypeUnbound:     TypeUnbond,
+	models.TxTypeRedeemCheck: TypeRedeemCheck,
+}
+
+type Resource struct {
+	Type               string                 `json:"type"`
+	Block              uint64                 `json:"block"`
+	Timestamp          string                 `json:"timestamp"`
+	Deltas             []resource.Interface   `json:"deltas"`
+	CommissionExcluded bool                   `json:"commission_excluded"`
+	Data               resource.ItemInterface `json:"data"`
+}
+
+type DeltaResource struct {
+	Coin  string `json:"coin"`
+	Value string `json:"value"`
+}
+
+type UnbondReturnResource struct {
+	Transaction string `json:"transaction"`
+	PubKey      string `json:"pub_key"`
+	Coin        string `json:"coin"`
+	Value       string `json:"value"`
+}
+
+type RewardResource struct {
+	Amount string `json:"amount"`
+	Count  uint64 `json:"count"`
+}
+
+// Entry of address timeline, commission paid in a custom coin is excluded from deltas
+// as it depends on the coin reserve at the block
+type Entry struct {
+	Type               string
+	BlockID            uint64
+	CreatedAt          time.Time
+	Deltas             []balance.TxDelta
+	CommissionExcluded bool
+	Data               resource.ItemInterface
+}
+
+func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	entry := model.(Entry)
+
+	return Resource{
+		Type:               entry.Type,
+		Block:              entry.BlockID,
+		Timestamp:          entry.CreatedAt.Format(time.RFC3339),
+		Deltas:             resource.TransformCollection(entry.Deltas, DeltaResource{}),
+		CommissionExcluded: entry.CommissionExcluded,
+		Data:               entry.Data,
+	}
+}
+
+func (DeltaResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	delta := model.(balance.TxDelta)
+
+	return DeltaResource{
+		Coin:  delta.Coin,
+		Value: helpers.QNoahStr2Noah(delta.Value.String()),
+	}
+}
+
+// Merge transactions, rewards, slashes and unbond returns of address into entries from the latest,
+// events of the end of the block go first. Slashes change stakes, not balances, so they have no deltas.
+func Merge(address string, baseCoin string, txs []models.Transaction, rewards []Reward, slashes []models.Slash, returns []UnbondReturn) ([]Entry, error) {
+	entries := make([]Entry, 0, len(txs)+len(rewards)+len(slashes)+len(returns))
+	for _, r := range returns {
+		value, err := parseValue(r.Data.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, Entry{
+			Type:      TypeUnbondReturn,
+			BlockID:   r.BlockID,
+			CreatedAt: r.CreatedAt,
+			Deltas:    []balance.TxDelta{{Coin: r.Data.Coin, Value: value}},
+			Data: UnbondReturnResource{
+				Transaction: `Nt` + r.Hash,
+				PubKey:      r.Data.PubKey,
+				Coin:        r.Data.Coin,
+				Value:       helpers.QNoahStr2Noah(r.Data.Value),
+			},
+		})
+	}
+
+	for _, reward := range rewards {
+		amount, err := parseValue(reward.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, Entry{
+			Type:      TypeReward,
+			BlockID:   reward.BlockID,
+			CreatedAt: reward.CreatedAt,
+			Deltas:    []balance.TxDelta{{Coin: baseCoin, Value: amount}},
+			Data:      RewardResource{Amount: helpers.QNoahStr2Noah(reward.Amount), Count: reward.Count},
+		})
+	}
+
+	for _, s := range slashes {
+		entries = append(entries, Entry{
+			Type:      TypeSlash,
+			BlockID:   s.BlockID,
+			CreatedAt: s.Block.CreatedAt,
+			Data:      new(slash.Resource).Transform(s),
+		})
+	}
+
+	for _, tx := range txs {
+		deltas, excluded, err := balance.TxDeltas(tx, address, baseCoin)
+		if err != nil {
+			return nil, err
+		}
+
+		entryType := txEntryTypes[tx.Type]
+		if tx.Type == models.TxTypeSend || tx.Type == models.TxTypeMultiSend {
+			entryType = TypeTransferIn
+			if tx.FromAddress.Address == address {
+				entryType = TypeTransferOut
+			}
+		}
+
+		entries = append(entries, Entry{
+			Type:               entryType,
+			BlockID:            tx.BlockID,
+			CreatedAt:          tx.CreatedAt,
+			Deltas:             deltas,
+			CommissionExcluded: excluded != "",
+			Data:               new(transaction.Resource).Transform(tx),
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].BlockID > entries[j].BlockID
+	})
+
+	return entries, nil
+}
+
+func parseValue(value string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, errors.NewMalformedData(fmt.Sprintf("Invalid balance change %s", value), nil)
+	}
+
+	return amount, nil
+}
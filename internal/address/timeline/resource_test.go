@@ -0,0 +1,47 @@
+package timeline
+
+import (
+	"testing"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+)
+
+const (
+	sender    = "1111111111111111111111111111111111111111"
+	recipient = "2222222222222222222222222222222222222222"
+)
+
+func tx(txType uint8, from string, data string, tags map[string]string) models.Transaction {
+	return models.Transaction{
+		Type:        txType,
+		Gas:         10,
+		GasPrice:    1,
+		Data:        []byte(data),
+		Tags:        tags,
+		FromAddress: &models.Address{Address: from},
+		GasCoin:     &models.Coin{Symbol: "NOAH"},
+	}
+}
+
+func TestMergeOrdersByBlock(t *testing.T) {
+	send := tx(models.TxTypeSend, sender, `{"coin":"NOAH","to":"NOAHx`+recipient+`","value":"1"}`, nil)
+	send.BlockID = 5
+
+	rewards := []Reward{{BlockID: 7, Amount: "2"}, {BlockID: 5, Amount: "3"}}
+	returns := []UnbondReturn{{BlockID: 6, Data: models.UnbondTxData{Coin: "TEST", Value: "4"}}}
+
+	merged, err := Merge(recipient, "NOAH", []models.Transaction{send}, rewards, nil, returns)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{TypeReward, TypeUnbondReturn, TypeReward, TypeTransferIn}
+	if len(merged) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(merged))
+	}
+	for i, entry := range merged {
+		if entry.Type != expected[i] {
+			t.Fatalf("expected %s at %d, got %s", expected[i], i, entry.Type)
+		}
+	}
+}
@@ -19,3 +19,21 @@ func (f SelectFilter) Filter(q *orm.Query) (*orm.Query, error) {
 
 	return q.Where("address.address = ?", f.Address), nil
 }
+
+type ValidatorSelectFilter struct {
+	ValidatorPubKey string
+	StartTime       *string
+	EndTime         *string
+}
+
+func (f ValidatorSelectFilter) Filter(q *orm.Query) (*orm.Query, error) {
+	if f.StartTime != nil {
+		q = q.Where("time_id >= ?", f.StartTime)
+	}
+
+	if f.EndTime != nil {
+		q = q.Where("time_id <= ?", f.EndTime)
+	}
+
+	return q.Where("validator.public_key = ?", f.ValidatorPubKey), nil
+}
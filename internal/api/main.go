@@ -1,9 +1,15 @@
 package api
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"net/http"
-	"sync"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
@@ -12,44 +18,96 @@ import (
 	apiV1 "github.com/noah-blockchain/noah-explorer-api/internal/api/v1"
 	"github.com/noah-blockchain/noah-explorer-api/internal/api/validators"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
 	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
 	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
-	"golang.org/x/time/rate"
+	"github.com/noah-blockchain/noah-explorer-api/internal/metrics"
+	"github.com/noah-blockchain/noah-explorer-api/internal/ratelimit"
 	"gopkg.in/go-playground/validator.v8"
 )
 
-// Run API
+// Run API until SIGTERM or SIGINT, returns after in-flight requests are drained
 func Run(db *pg.DB, explorer *core.Explorer) {
-	router := SetupRouter(db, explorer)
-	appAddress := fmt.Sprintf(":%d", explorer.Environment.ServerPort)
-	err := router.Run(appAddress)
+	registerCacheMetrics(explorer.Cache)
+
+	env := explorer.Environment
+	keys, err := ratelimit.NewKeyStore(env.ApiKeysFile, ratelimit.Tier{
+		Rate:  config.AnonymousRateLimit,
+		Burst: config.AnonymousRateBurst,
+	})
 	helpers.CheckErr(err)
+
+	limiter := ratelimit.NewLimiter(keys)
+	limiterCtx, stopLimiter := context.WithCancel(context.Background())
+	defer stopLimiter()
+	go limiter.Run(limiterCtx)
+
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", env.ServerPort),
+		Handler:           SetupRouter(db, explorer, limiter),
+		ReadTimeout:       env.HttpReadTimeout,
+		ReadHeaderTimeout: env.HttpReadHeaderTimeout,
+		WriteTimeout:      env.HttpWriteTimeout,
+		IdleTimeout:       env.HttpIdleTimeout,
+		MaxHeaderBytes:    env.HttpMaxHeaderBytes,
+	}
+
+	// close stream subscriptions, otherwise they keep connections active while draining
+	server.RegisterOnShutdown(explorer.Feed.Close)
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- server.ListenAndServe()
+	}()
+
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, syscall.SIGTERM, syscall.SIGINT)
+	defer signal.Stop(quit)
+
+	select {
+	case err := <-serverErr:
+		helpers.CheckErr(err)
+		return
+	case sig := <-quit:
+		log.Printf("api: received %s, shutting down", sig)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), env.HttpShutdownTimeout)
+	defer cancel()
+
+	if err := server.Shutdown(ctx); err != nil {
+		log.Printf("api: failed to drain connections: %s", err)
+	}
 }
 
 // Setup router
-func SetupRouter(db *pg.DB, explorer *core.Explorer) *gin.Engine {
+func SetupRouter(db *pg.DB, explorer *core.Explorer, limiter *ratelimit.Limiter) *gin.Engine {
 	router := gin.Default()
 
+	// request metrics by route templates known after applying routes
+	routes := make(routeNames)
+	router.Use(metricsMiddleware(&routes))
+
 	// Set release mode
 	if !explorer.Environment.IsDebug {
 		gin.SetMode(gin.ReleaseMode)
-		router.Use(apiRecovery) // returns 500 on any code panics
 	}
 
+	router.Use(errors.Middleware())         // map handler errors and panics to error responses
 	router.Use(cors.Default())              // CORS
-	router.Use(gin.ErrorLogger())           // print all errors
 	router.Use(apiMiddleware(db, explorer)) // init global context
 
-	// create ip map
-	ipMap := sync.Map{}
-	// rate limit
-	router.Use(throttle(ipMap))
+	// rate limit by API key or by IP for anonymous clients
+	router.Use(rateLimit(limiter))
 
 	// Default handler 404
 	router.NoRoute(func(c *gin.Context) {
 		errors.SetErrorResponse(http.StatusNotFound, http.StatusNotFound, "Resource not found.", c)
 	})
 
+	// Prometheus metrics
+	router.GET("/metrics", metrics.Handler)
+
 	// Create base api prefix
 	api := router.Group("/api")
 	{
@@ -57,6 +115,8 @@ func SetupRouter(db *pg.DB, explorer *core.Explorer) *gin.Engine {
 		apiV1.ApplyRoutes(api)
 	}
 
+	routes = newRouteNames(router)
+
 	// Register validator for api requests
 	registerApiValidators()
 
@@ -86,33 +146,70 @@ func registerApiValidators() {
 
 		err = v.RegisterValidation("timestamp", validators.Timestamp)
 		helpers.CheckErr(err)
-	}
-}
 
-// Send 500 status and JSON response
-func apiRecovery(c *gin.Context) {
-	defer func(c *gin.Context) {
-		if rec := recover(); rec != nil {
-			errors.SetErrorResponse(http.StatusInternalServerError, -1, "Internal server error", c)
-		}
-	}(c)
+		err = v.RegisterValidation("paginationCursor", validators.PaginationCursor)
+		helpers.CheckErr(err)
 
-	c.Next()
+		err = v.RegisterValidation("paginationAfter", validators.PaginationAfter)
+		helpers.CheckErr(err)
+
+		err = v.RegisterValidation("paginationAfterBlock", validators.PaginationAfter)
+		helpers.CheckErr(err)
+	}
 }
 
-func throttle(ipMap sync.Map) gin.HandlerFunc {
+// Header and query parameter with API key
+const (
+	apiKeyHeader     = "X-API-Key"
+	apiKeyQueryParam = "api_key"
+)
+
+func rateLimit(limiter *ratelimit.Limiter) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		limiter, ok := ipMap.Load(c.ClientIP())
-		if !ok {
-			limiter = rate.NewLimiter(5, 5)
-			ipMap.Store(c.ClientIP(), limiter)
+		key := c.GetHeader(apiKeyHeader)
+		if key == "" {
+			key = c.Query(apiKeyQueryParam)
 		}
 
-		if !limiter.(*rate.Limiter).Allow() {
+		result, err := limiter.Allow(key, c.ClientIP(), time.Now())
+		if err != nil {
+			errors.SetErrorResponse(http.StatusUnauthorized, -1, "Invalid API key", c)
+			c.Abort()
+			return
+		}
+
+		setRateLimitHeaders(c, result)
+
+		if !result.Allowed {
+			httpThrottledTotal.Inc()
+			c.Header("Retry-After", strconv.Itoa(ceilSeconds(result.RetryAfter)))
 			errors.SetErrorResponse(http.StatusTooManyRequests, -1, "Too many requests", c)
 			c.Abort()
-		} else {
-			c.Next()
+			return
 		}
+
+		c.Next()
+	}
+}
+
+func setRateLimitHeaders(c *gin.Context, result ratelimit.Result) {
+	c.Header("X-RateLimit-Tier", result.Tier.Name)
+	c.Header("X-RateLimit-Limit", strconv.Itoa(result.Tier.Burst))
+	c.Header("X-RateLimit-Remaining", strconv.Itoa(result.Remaining))
+	c.Header("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(result.Reset)))
+
+	if result.Tier.DailyQuota > 0 {
+		c.Header("X-RateLimit-Quota-Limit", strconv.Itoa(result.Tier.DailyQuota))
+		c.Header("X-RateLimit-Quota-Remaining", strconv.Itoa(result.QuotaRemaining))
+		c.Header("X-RateLimit-Quota-Reset", strconv.FormatInt(result.QuotaReset.Unix(), 10))
 	}
 }
+
+func ceilSeconds(d time.Duration) int {
+	seconds := int(d / time.Second)
+	if d%time.Second != 0 {
+		seconds++
+	}
+
+	return seconds
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/noah-blockchain/noah-explorer-api/internal/metrics"
+	"github.com/noah-blockchain/noah-explorer-api/internal/tools/cache"
+)
+
+var (
+	httpRequestsTotal = metrics.NewCounterVec(
+		"explorer_http_requests_total",
+		"Count of HTTP requests by route and status code.",
+		"method", "route", "status",
+	)
+
+	httpRequestDuration = metrics.NewHistogramVec(
+		"explorer_http_request_duration_seconds",
+		"Latency of HTTP requests by route.",
+		metrics.DefaultBuckets,
+		"method", "route",
+	)
+
+	httpThrottledTotal = metrics.NewCounterVec(
+		"explorer_http_throttled_requests_total",
+		"Count of HTTP requests rejected by the rate limiter.",
+	)
+)
+
+// Route templates by method and handler name, filled after routes are applied
+type routeNames map[string]string
+
+func newRouteNames(router *gin.Engine) routeNames {
+	names := make(routeNames)
+	for _, route := range router.Routes() {
+		names[route.Method+" "+route.Handler] = route.Path
+	}
+
+	return names
+}
+
+func (names routeNames) get(c *gin.Context) string {
+	if route, ok := names[c.Request.Method+" "+c.HandlerName()]; ok {
+		return route
+	}
+
+	return "unmatched"
+}
+
+// Collect requests count and latency per route
+func metricsMiddleware(names *routeNames) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := names.get(c)
+		httpRequestDuration.Observe(time.Since(start).Seconds(), c.Request.Method, route)
+		httpRequestsTotal.Inc(c.Request.Method, route, strconv.Itoa(c.Writer.Status()))
+	}
+}
+
+// Register gauges reading the explorer cache state on scrape
+func registerCacheMetrics(explorerCache *cache.ExplorerCache) {
+	metrics.NewGaugeFunc("explorer_cache_items", "Count of items stored in the cache.", func() float64 {
+		return float64(explorerCache.Len())
+	})
+
+	metrics.NewCounterFunc("explorer_cache_hits_total", "Count of cache reads returning a stored item.", func() float64 {
+		return float64(explorerCache.Hits())
+	})
+
+	metrics.NewCounterFunc("explorer_cache_misses_total", "Count of cache reads calling the value callback.", func() float64 {
+		return float64(explorerCache.Misses())
+	})
+}
@@ -0,0 +1,28 @@
+package addresses
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+)
+
+// Refresh rankings of addresses on schedule, the rankings are created before the api starts
+func RefreshRichList(ctx context.Context, explorer *core.Explorer) {
+	ticker := time.NewTicker(config.RichListRefreshPeriodInSec * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := explorer.RichListRepository.Refresh(); err != nil {
+			log.Printf("addresses: failed to refresh rich list: %s", err)
+		}
+	}
+}
@@ -2,29 +2,34 @@ package addresses
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/noah-blockchain/coinExplorer-tools/models"
 	"github.com/noah-blockchain/noah-explorer-api/internal/address"
+	addressTimeline "github.com/noah-blockchain/noah-explorer-api/internal/address/timeline"
 	"github.com/noah-blockchain/noah-explorer-api/internal/aggregated_reward"
+	"github.com/noah-blockchain/noah-explorer-api/internal/balance"
 	"github.com/noah-blockchain/noah-explorer-api/internal/chart"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
 	"github.com/noah-blockchain/noah-explorer-api/internal/delegation"
 	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
 	"github.com/noah-blockchain/noah-explorer-api/internal/events"
+	"github.com/noah-blockchain/noah-explorer-api/internal/export"
 	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/market"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
 	"github.com/noah-blockchain/noah-explorer-api/internal/reward"
+	"github.com/noah-blockchain/noah-explorer-api/internal/rich_list"
 	"github.com/noah-blockchain/noah-explorer-api/internal/slash"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
 	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
 	validatorMeta "github.com/noah-blockchain/noah-explorer-api/internal/validator/meta"
 )
 
-const (
-	precision = 100
-)
-
 type GetAddressRequest struct {
 	Address string `uri:"address" binding:"noahAddress"`
 }
@@ -40,28 +45,88 @@ type FilterQueryRequest struct {
 	Page       *string `form:"page"       binding:"omitempty,numeric"`
 }
 
+// TODO: replace string to int
+type TransactionsQueryRequest struct {
+	StartBlock *string `form:"startblock" binding:"omitempty,numeric"`
+	EndBlock   *string `form:"endblock"   binding:"omitempty,numeric"`
+	Page       *string `form:"page"       binding:"omitempty,numeric"`
+	Cursor     *string `form:"cursor"     binding:"omitempty,paginationCursor"`
+	After      *string `form:"after"      binding:"omitempty,paginationAfter"`
+	Direction  *string `form:"direction"  binding:"omitempty,eq=in|eq=out"`
+	transaction.FilterRequest
+}
+
+type TimelineQueryRequest struct {
+	Cursor *string `form:"cursor" binding:"omitempty,paginationCursor"`
+	After  *string `form:"after"  binding:"omitempty,paginationAfterBlock"`
+}
+
+type ExportQueryRequest struct {
+	Format     string  `form:"format"     binding:"omitempty,eq=csv|eq=ndjson"`
+	StartBlock *string `form:"startblock" binding:"omitempty,numeric"`
+	EndBlock   *string `form:"endblock"   binding:"omitempty,numeric"`
+	StartTime  *string `form:"startTime"  binding:"omitempty,timestamp"`
+	EndTime    *string `form:"endTime"    binding:"omitempty,timestamp"`
+}
+
 type StatisticsQueryRequest struct {
 	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
 	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
 }
 
+type BalancesQueryRequest struct {
+	AtBlock *string `form:"at_block" binding:"omitempty,numeric"`
+}
+
+type BalancesStatisticsQueryRequest struct {
+	Coin      *string `form:"coin"      binding:"omitempty,max=10"`
+	Scale     *string `form:"scale"     binding:"omitempty,eq=minute|eq=hour|eq=day"`
+	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
+	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
+}
+
 type AggregatedRewardsQueryRequest struct {
 	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
 	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
 }
 
-//Get list of addresses ranked by balance
+// Get list of addresses ranked by base coin value of balances and stakes
 func GetTopAddresses(c *gin.Context) {
 	explorer := c.MustGet("explorer").(*core.Explorer)
 
-	//fetch address
 	pagination := tools.NewPagination(c.Request)
-	addresses := explorer.AddressRepository.GetPaginatedAddresses(&pagination)
+	entries, err := explorer.RichListRepository.GetPaginated(&pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	rates := explorer.Market.Rates()
+	c.JSON(http.StatusOK, resource.TransformPaginatedCollectionWithCallback(entries, rich_list.Resource{}, pagination,
+		func(model resource.ParamInterface) resource.ParamsInterface {
+			return resource.ParamsInterface{rates}
+		}))
+}
+
+// Get rank of address in the list of top addresses
+func GetTopAddress(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	noahAddress, err := getAddressFromRequestUri(c)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	entry, err := explorer.RichListRepository.GetByAddress(*noahAddress)
+	if err != nil {
+		c.Error(errors.WithNotFoundMessage(err, "Address is not ranked."))
+		return
+	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"data": address.ResourceTopAddresses{}.TransformCollection(addresses, pagination),
+		"data": new(rich_list.Resource).Transform(*entry, explorer.Market.Rates()),
 	})
-
 }
 
 // Get list of addresses
@@ -83,7 +148,11 @@ func GetAddresses(c *gin.Context) {
 	}
 
 	// fetch addresses
-	addresses := explorer.AddressRepository.GetByAddresses(noahAddresses)
+	addresses, err := explorer.AddressRepository.GetByAddresses(noahAddresses)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	// extend the model array with empty model if not exists
 	if len(addresses) != len(noahAddresses) {
@@ -97,7 +166,9 @@ func GetAddresses(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"data": resource.TransformCollection(addresses, address.Resource{}),
+		"data": resource.TransformCollectionWithCallback(addresses, address.Resource{}, func(model resource.ParamInterface) resource.ParamsInterface {
+			return resource.ParamsInterface{explorer.Market.Rates()}
+		}),
 	})
 }
 
@@ -113,14 +184,13 @@ func GetAddress(c *gin.Context) {
 	}
 
 	// fetch address
-	model := explorer.AddressRepository.GetByAddress(*noahAddress)
-
-	// if model not found
-	if model == nil {
-		model = makeEmptyAddressModel(*noahAddress, explorer.Environment.BaseCoin)
+	model, err := getAddressOrEmpty(explorer, *noahAddress)
+	if err != nil {
+		c.Error(err)
+		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"data": new(address.Resource).Transform(*model)})
+	c.JSON(http.StatusOK, gin.H{"data": new(address.Resource).Transform(*model, explorer.Market.Rates())})
 }
 
 // Get list of transactions by noah address
@@ -134,7 +204,7 @@ func GetTransactions(c *gin.Context) {
 	}
 
 	// validate request query
-	var requestQuery FilterQueryRequest
+	var requestQuery TransactionsQueryRequest
 	err = c.ShouldBindQuery(&requestQuery)
 	if err != nil {
 		errors.SetValidationErrorResponse(err, c)
@@ -142,17 +212,88 @@ func GetTransactions(c *gin.Context) {
 	}
 
 	// fetch data
-	pagination := tools.NewPagination(c.Request)
-	txs := explorer.TransactionRepository.GetPaginatedTxsByAddresses(
+	pagination := tools.NewCursorPagination(c.Request)
+	txs, err := explorer.TransactionRepository.GetPaginatedTxsByAddresses(
 		[]string{*noahAddress},
-		transaction.BlocksRangeSelectFilter{
-			StartBlock: requestQuery.StartBlock,
-			EndBlock:   requestQuery.EndBlock,
+		tools.Filters{
+			transaction.BlocksRangeSelectFilter{
+				StartBlock: requestQuery.StartBlock,
+				EndBlock:   requestQuery.EndBlock,
+			},
+			requestQuery.FilterRequest.Filter().WithDirection(requestQuery.Direction, []string{*noahAddress}),
 		}, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(txs, transaction.Resource{}, pagination))
 }
 
+// Get transfers, conversions, delegations, unbonds and their returns, check redemptions, rewards and slashes
+// of address from the latest with changes of balances they caused
+func GetTimeline(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	noahAddress, err := getAddressFromRequestUri(c)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query TimelineQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// entries are paginated by block height cursor only
+	pagination := tools.NewCursorPagination(c.Request)
+	pagination.Keyset = true
+	limit := pagination.GetPerPage() + 1
+
+	// fetch data
+	txs, err := explorer.AddressTimelineRepository.GetTransactions(*noahAddress, pagination.Cursor, limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	rewards, err := explorer.AddressTimelineRepository.GetRewards(*noahAddress, pagination.Cursor, limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	slashes, err := explorer.AddressTimelineRepository.GetSlashes(*noahAddress, pagination.Cursor, limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	returns, err := explorer.AddressTimelineRepository.GetUnbondReturns(*noahAddress, pagination.Cursor, limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	entries, err := addressTimeline.Merge(*noahAddress, explorer.Environment.BaseCoin, txs, rewards, slashes, returns)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	blocks := make([]uint64, len(entries))
+	for i, entry := range entries {
+		blocks[i] = entry.BlockID
+	}
+
+	end, nextCursor := tools.PageByBlocks(blocks, pagination.GetPerPage())
+	pagination.NextCursor = nextCursor
+
+	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(entries[:end], addressTimeline.Resource{}, pagination))
+}
+
 // Get list of rewards by Noah address
 func GetRewards(c *gin.Context) {
 	explorer := c.MustGet("explorer").(*core.Explorer)
@@ -164,7 +305,11 @@ func GetRewards(c *gin.Context) {
 	}
 
 	// fetch data
-	rewards := explorer.RewardRepository.GetPaginatedByAddress(*filter, pagination)
+	rewards, err := explorer.RewardRepository.GetPaginatedByAddress(*filter, pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(rewards, reward.Resource{}, *pagination))
 }
@@ -186,11 +331,15 @@ func GetAggregatedRewards(c *gin.Context) {
 
 	// fetch data
 	pagination := tools.NewPagination(c.Request)
-	rewards := explorer.RewardRepository.GetPaginatedAggregatedByAddress(aggregated_reward.SelectFilter{
+	rewards, err := explorer.RewardRepository.GetPaginatedAggregatedByAddress(aggregated_reward.SelectFilter{
 		Address:   *noahAddress,
 		StartTime: requestQuery.StartBlock,
 		EndTime:   requestQuery.EndBlock,
 	}, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(rewards, aggregated_reward.Resource{}, pagination))
 }
@@ -206,7 +355,11 @@ func GetSlashes(c *gin.Context) {
 	}
 
 	// fetch data
-	slashes := explorer.SlashRepository.GetPaginatedByAddress(*filter, pagination)
+	slashes, err := explorer.SlashRepository.GetPaginatedByAddress(*filter, pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(slashes, slash.Resource{}, *pagination))
 }
@@ -216,7 +369,7 @@ func GetDelegations(c *gin.Context) {
 	explorer := c.MustGet("explorer").(*core.Explorer)
 
 	noahAddress, err := getAddressFromRequestUri(c)
-	if err != nil || noahAddress == nil {
+	if err != nil {
 		errors.SetValidationErrorResponse(err, c)
 		return
 	}
@@ -225,48 +378,45 @@ func GetDelegations(c *gin.Context) {
 
 	stakesSum, err := explorer.StakeRepository.GetSumInNoahValueByAddress(*noahAddress)
 	if err != nil {
-		errors.SetValidationErrorResponse(err, c)
+		c.Error(err)
 		return
 	}
 
-	stakes := explorer.StakeRepository.GetPaginatedByAddress(*noahAddress, &pagination)
-	delegatedStakeList := make([]delegation.Resource, len(stakes))
+	stakes, err := explorer.StakeRepository.GetPaginatedByAddress(*noahAddress, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+	stakeIds := make([]uint64, len(stakes))
 	for i, stake := range stakes {
+		stakeIds[i] = stake.ID
+	}
 
-		yourMoney := helpers.NewFloat(0, precision)
-		//if *stake.Validator.Commission < 100 {
-		//	fmt.Println(*stake.Validator.Commission)
-		//	//// get sum reward validator from time created >= stake.created_at
-		//	sumReward := explorer.RewardRepository.GetSumRewardForValidator(stake.ValidatorID, stake.CreatedAt)
-		//	sumRewardBigFloat, _ := helpers.NewFloat(0, precision).SetString(sumReward)
-		//	log.Println("sumReward", sumReward)
-		//	log.Println("sumRewardFloat", sumRewardBigFloat.String())
-		//
-		//	//// ((sum_reward-(sum_reward * commission_validator_%)) * stake_%) = profit
-		//	validatorsMoney := helpers.NewFloat(0, precision)
-		//	validatorsMoney = validatorsMoney.Mul(sumRewardBigFloat, big.NewFloat(float64(*stake.Validator.Commission)/100))
-		//	log.Println("commission", big.NewFloat(float64(*stake.Validator.Commission)/100).String())
-		//	log.Println("validatorsMoney", validatorsMoney.String())
-		//
-		//	delegationsMoney := validatorsMoney.Sub(sumRewardBigFloat, validatorsMoney)
-		//	log.Println("delegationsMoney", delegationsMoney.String())
-		//
-		//	//// get your stake_% from delegations total stake
-		//	percentYourStake, _ := helpers.NewFloat(0, precision).SetString(stake.NoahValue)
-		//	percentYourStake = percentYourStake.Mul(percentYourStake, big.NewFloat(100))
-		//	percentYourStake = percentYourStake.Quo(percentYourStake, delegationsMoney)
-		//	log.Println("percentYourStake", percentYourStake.String())
-		//
-		//	yourMoney = delegationsMoney.Mul(delegationsMoney, percentYourStake)
-		//	yourMoney = yourMoney.Quo(yourMoney, big.NewFloat(100))
-		//}
+	profits, err := explorer.RewardRepository.GetDelegatorProfitsByStakes(*noahAddress, stakeIds)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	profitByStake := make(map[uint64]string, len(profits))
+	for _, profit := range profits {
+		profitByStake[profit.StakeID] = profit.Amount
+	}
+
+	delegatedStakeList := make([]delegation.Resource, len(stakes))
+	for i, stake := range stakes {
+		profit, ok := profitByStake[stake.ID]
+		if !ok {
+			profit = "0"
+		}
 
 		delegatedStakeList[i] = delegation.Resource{
 			Coin:           stake.Coin.Symbol,
 			PubKey:         stake.Validator.GetPublicKey(),
 			Value:          helpers.QNoahStr2Noah(stake.Value),
 			NoahValue:      helpers.QNoahStr2Noah(stake.NoahValue),
-			ProfitReceived: helpers.QNoahStr2Noah(yourMoney.String()),
+			ProfitReceived: helpers.QNoahStr2Noah(profit),
+			ProfitYield:    delegation.ProfitYield(profit, stake.NoahValue),
 			ValidatorMeta:  new(validatorMeta.Resource).Transform(*stake.Validator),
 		}
 	}
@@ -300,17 +450,304 @@ func GetRewardsStatistics(c *gin.Context) {
 	}
 
 	// fetch data
-	chartData := explorer.RewardRepository.GetAggregatedChartData(aggregated_reward.SelectFilter{
+	chartData, err := explorer.RewardRepository.GetAggregatedChartData(aggregated_reward.SelectFilter{
 		Address:   *noahAddress,
 		EndTime:   requestQuery.EndTime,
 		StartTime: requestQuery.StartTime,
 	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"data": resource.TransformCollection(chartData, chart.RewardResource{}),
 	})
 }
 
+// Get balances of noah address, at the block if requested
+func GetBalances(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	noahAddress, err := getAddressFromRequestUri(c)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var requestQuery BalancesQueryRequest
+	if err := c.ShouldBindQuery(&requestQuery); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	model, err := getAddressOrEmpty(explorer, *noahAddress)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	balances := make([]models.Balance, len(model.Balances))
+	for i, item := range model.Balances {
+		balances[i] = *item
+	}
+
+	rates := explorer.Market.Rates()
+	excluded := make(map[string]bool)
+	if requestQuery.AtBlock != nil {
+		blockId, err := strconv.ParseUint(*requestQuery.AtBlock, 10, 64)
+		if err != nil {
+			c.Error(errors.NewInvalidInput("Invalid block height.", err))
+			return
+		}
+
+		block, err := explorer.BlockRepository.GetById(blockId)
+		if err != nil {
+			c.Error(errors.WithNotFoundMessage(err, "Block not found."))
+			return
+		}
+
+		// the base coin is valued by the market price at the block, custom coins by their current prices
+		rates, err = explorer.Market.RatesAt(block.CreatedAt)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		deltas, err := explorer.BalanceRepository.GetDeltasAfterBlock(*noahAddress, blockId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		txs, err := explorer.BalanceRepository.GetTransactionsAfterBlock(*noahAddress, blockId)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		changes, err := balance.TxChanges(txs, *noahAddress, explorer.Environment.BaseCoin)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		balances, excluded, err = balance.AtBlock(model.Balances, deltas, changes)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		// balances at the block have symbols of coins only
+		symbols := make([]string, len(balances))
+		for i, item := range balances {
+			symbols[i] = item.Coin.Symbol
+		}
+
+		coinModels, err := explorer.CoinRepository.GetBySymbols(symbols)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		setBalanceCoins(balances, coinModels)
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": transformBalances(balances, rates, excluded)})
+}
+
+// Transform balances with fiat values by rates, flagging coins with the commission excluded from changes
+func transformBalances(balances []models.Balance, rates market.Rates, excluded map[string]bool) []resource.Interface {
+	return resource.TransformCollectionWithCallback(balances, balance.Resource{}, func(model resource.ParamInterface) resource.ParamsInterface {
+		return resource.ParamsInterface{rates, excluded[model.(models.Balance).Coin.Symbol]}
+	})
+}
+
+// Replace coins of balances by the coin models with prices
+func setBalanceCoins(balances []models.Balance, coinModels []models.Coin) {
+	bySymbol := make(map[string]*models.Coin, len(coinModels))
+	for i := range coinModels {
+		bySymbol[coinModels[i].Symbol] = &coinModels[i]
+	}
+
+	for i := range balances {
+		if coin, ok := bySymbol[balances[i].Coin.Symbol]; ok {
+			balances[i].Coin = coin
+		}
+	}
+}
+
+// Get balance history of coin by noah address
+func GetBalancesStatistics(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	noahAddress, err := getAddressFromRequestUri(c)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var requestQuery BalancesStatisticsQueryRequest
+	if err := c.ShouldBindQuery(&requestQuery); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// set defaults
+	coin := explorer.Environment.BaseCoin
+	if requestQuery.Coin != nil {
+		coin = strings.ToUpper(*requestQuery.Coin)
+	}
+
+	scale := config.DefaultStatisticsScale
+	if requestQuery.Scale != nil {
+		scale = *requestQuery.Scale
+	}
+
+	startTime := helpers.StartOfTheDay(time.Now().AddDate(0, 0, config.DefaultStatisticsDayDelta))
+	if requestQuery.StartTime != nil {
+		startTime, _ = helpers.ParseTimestamp(*requestQuery.StartTime)
+	}
+
+	// current balance is the starting point of history
+	model, err := getAddressOrEmpty(explorer, *noahAddress)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	current := "0"
+	for _, item := range model.Balances {
+		if item.Coin.Symbol == coin {
+			current = item.Value
+		}
+	}
+
+	since := balance.Truncate(startTime, scale)
+	deltas, err := explorer.BalanceRepository.GetDeltasByPeriods(*noahAddress, coin, scale, since)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	txs, err := explorer.BalanceRepository.GetTransactionsSince(*noahAddress, since)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	changes, err := balance.TxChanges(txs, *noahAddress, explorer.Environment.BaseCoin)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	points, err := balance.History(current, coin, deltas, changes, scale)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	if requestQuery.EndTime != nil {
+		endTime, _ := helpers.ParseTimestamp(*requestQuery.EndTime)
+		for i, point := range points {
+			if point.Time.After(endTime) {
+				points = points[:i]
+				break
+			}
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resource.TransformCollection(points, chart.BalanceResource{}),
+	})
+}
+
+// Export all transactions of address, one row per transfer
+func ExportTransactions(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	filter, format, err := prepareExportRequest(c)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	response := export.NewResponse(c.Writer, format, c.Param("address")+"-transactions", transaction.TransferResource{})
+	err = explorer.TransactionRepository.ForEachTransferByAddress(c.Request.Context(), *filter, func(transfer *transaction.Transfer) error {
+		return response.Write(new(transaction.TransferResource).Transform(*transfer))
+	})
+
+	finishExport(c, response, err)
+}
+
+// Export all rewards of address
+func ExportRewards(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	filter, format, err := prepareExportRequest(c)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	response := export.NewResponse(c.Writer, format, c.Param("address")+"-rewards", reward.ExportResource{})
+	err = explorer.RewardRepository.ForEachByAddress(c.Request.Context(), *filter, func(row *reward.ExportRow) error {
+		return response.Write(new(reward.ExportResource).Transform(*row))
+	})
+
+	finishExport(c, response, err)
+}
+
+// Export all slashes of address
+func ExportSlashes(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	filter, format, err := prepareExportRequest(c)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	response := export.NewResponse(c.Writer, format, c.Param("address")+"-slashes", slash.ExportResource{})
+	err = explorer.SlashRepository.ForEachByAddress(c.Request.Context(), *filter, func(row *slash.ExportRow) error {
+		return response.Write(new(slash.ExportResource).Transform(*row))
+	})
+
+	finishExport(c, response, err)
+}
+
+func prepareExportRequest(c *gin.Context) (*export.Filter, string, error) {
+	noahAddress, err := getAddressFromRequestUri(c)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var requestQuery ExportQueryRequest
+	if err := c.ShouldBindQuery(&requestQuery); err != nil {
+		return nil, "", err
+	}
+
+	return &export.Filter{
+		Address:    *noahAddress,
+		StartBlock: requestQuery.StartBlock,
+		EndBlock:   requestQuery.EndBlock,
+		StartTime:  requestQuery.StartTime,
+		EndTime:    requestQuery.EndTime,
+	}, requestQuery.Format, nil
+}
+
+// Errors after the first row are only logged, the client gets truncated export
+func finishExport(c *gin.Context, response *export.Response, err error) {
+	if err == nil {
+		err = response.Close()
+	}
+
+	if err != nil {
+		c.Error(err)
+	}
+}
+
 func prepareEventsRequest(c *gin.Context) (*events.SelectFilter, *tools.Pagination, error) {
 	noahAddress, err := getAddressFromRequestUri(c)
 	if err != nil {
@@ -342,6 +779,16 @@ func getAddressFromRequestUri(c *gin.Context) (*string, error) {
 	return &noahAddress, nil
 }
 
+// Get address model, address without transactions has zero base coin balance
+func getAddressOrEmpty(explorer *core.Explorer, noahAddress string) (*models.Address, error) {
+	model, err := explorer.AddressRepository.GetByAddress(noahAddress)
+	if errors.IsNotFound(err) {
+		return makeEmptyAddressModel(noahAddress, explorer.Environment.BaseCoin), nil
+	}
+
+	return model, err
+}
+
 // Return model address with zero base coin
 func makeEmptyAddressModel(noahAddress string, baseCoin string) *models.Address {
 	return &models.Address{
@@ -0,0 +1,40 @@
+package addresses
+
+import (
+	"testing"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/balance"
+	"github.com/noah-blockchain/noah-explorer-api/internal/market"
+)
+
+func TestBalancesAtBlockHaveCustomCoinFiatValue(t *testing.T) {
+	current := []*models.Balance{
+		{Coin: &models.Coin{Symbol: "NOAH"}, Value: "10000000000000000000"},
+		{Coin: &models.Coin{Symbol: "TEST", Price: "3000000000000000000"}, Value: "10000000000000000000"},
+	}
+
+	// 5 TEST received after the block
+	deltas := []balance.Delta{{Coin: "TEST", Value: "5000000000000000000"}}
+
+	balances, excluded, err := balance.AtBlock(current, deltas, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	setBalanceCoins(balances, []models.Coin{{Symbol: "NOAH"}, {Symbol: "TEST", Price: "3000000000000000000"}})
+
+	rates := market.Rates{BaseCoin: "NOAH", Price: &market.Price{Value: 0.5}}
+	expected := map[string]string{"NOAH": "5.00", "TEST": "7.50"}
+	resources := transformBalances(balances, rates, excluded)
+	if len(resources) != len(expected) {
+		t.Fatalf("expected %d balances, got %d", len(expected), len(resources))
+	}
+
+	for _, item := range resources {
+		res := item.(balance.Resource)
+		if res.FiatValue == nil || *res.FiatValue != expected[res.Coin] {
+			t.Fatalf("expected fiat value %s of %s, got %v", expected[res.Coin], res.Coin, res.FiatValue)
+		}
+	}
+}
@@ -9,12 +9,19 @@ func ApplyRoutes(r *gin.RouterGroup) {
 	{
 		addresses.GET("", GetAddresses)
 		top.GET("", GetTopAddresses)
+		top.GET("/:address", GetTopAddress)
 		addresses.GET("/:address", GetAddress)
 		addresses.GET("/:address/transactions", GetTransactions)
+		addresses.GET("/:address/timeline", GetTimeline)
+		addresses.GET("/:address/balances", GetBalances)
 		addresses.GET("/:address/events/rewards", GetRewards)
 		addresses.GET("/:address/events/slashes", GetSlashes)
 		addresses.GET("/:address/delegations", GetDelegations)
 		addresses.GET("/:address/statistics/rewards", GetRewardsStatistics)
+		addresses.GET("/:address/statistics/balances", GetBalancesStatistics)
 		addresses.GET("/:address/events/rewards/aggregated", GetAggregatedRewards)
+		addresses.GET("/:address/export/transactions", ExportTransactions)
+		addresses.GET("/:address/export/rewards", ExportRewards)
+		addresses.GET("/:address/export/slashes", ExportSlashes)
 	}
 }
@@ -10,7 +10,6 @@ import (
 	"github.com/noah-blockchain/noah-explorer-api/internal/blocks"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core"
 	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
-	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
 	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
@@ -23,7 +22,9 @@ type GetBlockRequest struct {
 
 // TODO: replace string to int
 type GetBlocksRequest struct {
-	Page string `form:"page" binding:"omitempty,numeric"`
+	Page   string  `form:"page"   binding:"omitempty,numeric"`
+	Cursor *string `form:"cursor" binding:"omitempty,paginationCursor"`
+	After  *string `form:"after"  binding:"omitempty,paginationAfter"`
 }
 
 // Blocks cache helpers
@@ -39,23 +40,36 @@ func GetBlocks(c *gin.Context) {
 	var blockModels []models.Block
 	explorer := c.MustGet("explorer").(*core.Explorer)
 
-	// fetch blocks
-	pagination := tools.NewPagination(c.Request)
-
-	getBlocks := func() []models.Block {
-		return explorer.BlockRepository.GetPaginated(&pagination)
+	// validate request query
+	var request GetBlocksRequest
+	err := c.ShouldBindQuery(&request)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
 	}
 
-	// cache last blocks
-	if pagination.GetCurrentPage() == 1 && pagination.GetPerPage() == tools.DefaultLimit {
-		cached := explorer.Cache.Get("blocks", func() interface{} {
-			return CacheBlocksData{getBlocks(), pagination}
-		}, CacheBlocksCount).(CacheBlocksData)
+	// fetch blocks
+	pagination := tools.NewCursorPagination(c.Request)
 
-		blockModels = cached.Blocks
-		pagination = cached.Pagination
+	// cache last blocks
+	if !pagination.Keyset && pagination.GetCurrentPage() == 1 && pagination.GetPerPage() == tools.DefaultLimit {
+		cached, err := explorer.Cache.Get("blocks", func() (interface{}, error) {
+			blockModels, err := explorer.BlockRepository.GetPaginated(&pagination)
+			return CacheBlocksData{blockModels, pagination}, err
+		}, CacheBlocksCount)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		blockModels = cached.(CacheBlocksData).Blocks
+		pagination = cached.(CacheBlocksData).Pagination
 	} else {
-		blockModels = getBlocks()
+		blockModels, err = explorer.BlockRepository.GetPaginated(&pagination)
+		if err != nil {
+			c.Error(err)
+			return
+		}
 	}
 
 	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(blockModels, blocks.Resource{}, pagination))
@@ -75,14 +89,15 @@ func GetBlock(c *gin.Context) {
 
 	// parse to uint64
 	blockId, err := strconv.ParseUint(request.ID, 10, 64)
-	helpers.CheckErr(err)
+	if err != nil {
+		c.Error(errors.NewInvalidInput("Invalid block height.", err))
+		return
+	}
 
 	// fetch block by height
-	block := explorer.BlockRepository.GetById(blockId)
-
-	// check block to existing
-	if block == nil {
-		errors.SetErrorResponse(http.StatusNotFound, http.StatusNotFound, "Block not found.", c)
+	block, err := explorer.BlockRepository.GetById(blockId)
+	if err != nil {
+		c.Error(errors.WithNotFoundMessage(err, "Block not found."))
 		return
 	}
 
@@ -91,6 +106,37 @@ func GetBlock(c *gin.Context) {
 	})
 }
 
+// Get validator set of block with signatures
+func GetBlockValidators(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetBlockRequest
+	err := c.ShouldBindUri(&request)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// parse to uint64
+	blockId, err := strconv.ParseUint(request.ID, 10, 64)
+	if err != nil {
+		c.Error(errors.NewInvalidInput("Invalid block height.", err))
+		return
+	}
+
+	// fetch block by height
+	block, err := explorer.BlockRepository.GetById(blockId)
+	if err != nil {
+		c.Error(errors.WithNotFoundMessage(err, "Block not found."))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resource.TransformCollection(block.BlockValidators, blocks.ValidatorResource{}),
+	})
+}
+
 // Get list of transactions by block height
 func GetBlockTransactions(c *gin.Context) {
 	explorer := c.MustGet("explorer").(*core.Explorer)
@@ -113,13 +159,20 @@ func GetBlockTransactions(c *gin.Context) {
 
 	// parse to uint64
 	blockId, err := strconv.ParseUint(request.ID, 10, 64)
-	helpers.CheckErr(err)
+	if err != nil {
+		c.Error(errors.NewInvalidInput("Invalid block height.", err))
+		return
+	}
 
 	// fetch data
-	pagination := tools.NewPagination(c.Request)
-	txs := explorer.TransactionRepository.GetPaginatedTxsByFilter(transaction.BlockFilter{
+	pagination := tools.NewCursorPagination(c.Request)
+	txs, err := explorer.TransactionRepository.GetPaginatedTxsByFilter(transaction.BlockFilter{
 		BlockId: blockId,
 	}, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(txs, transaction.Resource{}, pagination))
 }
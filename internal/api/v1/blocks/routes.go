@@ -11,5 +11,6 @@ func ApplyRoutes(r *gin.RouterGroup) {
 		blocks.GET("", GetBlocks)
 		blocks.GET("/:height", GetBlock)
 		blocks.GET("/:height/transactions", GetBlockTransactions)
+		blocks.GET("/:height/validators", GetBlockValidators)
 	}
 }
@@ -0,0 +1,52 @@
+package coins
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+)
+
+// Collect minute candles of coins from new transactions on schedule,
+// the last collected minute is collected again as it may be incomplete
+func CollectCandles(ctx context.Context, explorer *core.Explorer) {
+	ticker := time.NewTicker(config.CoinCandlesCollectPeriodInSec * time.Second)
+	defer ticker.Stop()
+
+	for {
+		since, err := explorer.CandleRepository.GetLastTime()
+		if err == nil {
+			err = explorer.CandleRepository.Collect(since)
+		}
+
+		if err != nil {
+			log.Printf("coins: failed to collect candles: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Save daily snapshots of holders counts of all coins on schedule, days when the api is down have no snapshots
+func CollectHolderSnapshots(ctx context.Context, explorer *core.Explorer) {
+	ticker := time.NewTicker(config.CoinHoldersSnapshotPeriodInSec * time.Second)
+	defer ticker.Stop()
+
+	for {
+		if err := explorer.HolderRepository.SaveSnapshots(time.Now().UTC()); err != nil {
+			log.Printf("coins: failed to save holder snapshots: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
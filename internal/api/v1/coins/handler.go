@@ -1,16 +1,24 @@
 package coins
 
 import (
+	"log"
+	"math/big"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/noah-blockchain/coinExplorer-tools/models"
 	"github.com/noah-blockchain/noah-explorer-api/internal/balance"
+	"github.com/noah-blockchain/noah-explorer-api/internal/candle"
 	"github.com/noah-blockchain/noah-explorer-api/internal/coins"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
 	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
 	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/holder"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+	"github.com/noah-blockchain/noah-explorer-api/internal/rich_list"
 	"github.com/noah-blockchain/noah-explorer-api/internal/stake"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
 	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
@@ -28,22 +36,56 @@ type GetCoinsRequest struct {
 	OrderBy *string `form:"order_by" binding:"omitempty"`
 }
 
+// Coin fields allowed in the filter parameter
+var SortFields = []string{"crr", "volume", "reserve_balance", "symbol", "price", "capitalization", "delegated"}
+
 type GetCoinBySymbolRequest struct {
 	Symbol string `uri:"symbol"`
 }
 
+type EstimateRequest struct {
+	Type   string  `form:"type"   binding:"required,eq=buy|eq=sell"`
+	Amount string  `form:"amount" binding:"required,numeric"`
+	Coin   *string `form:"coin"   binding:"omitempty,max=10"`
+}
+
+type GetAddressBalancesRequest struct {
+	Page    string  `form:"page"     binding:"omitempty,numeric"`
+	OrderBy *string `form:"order_by" binding:"omitempty,eq=ASC|eq=DESC"`
+}
+
+type GetCoinAddressRequest struct {
+	Symbol  string `uri:"symbol"`
+	Address string `uri:"address" binding:"noahAddress"`
+}
+
+type GetHoldersHistoryRequest struct {
+	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
+	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
+}
+
+type GetCandlesRequest struct {
+	Scale     *string `form:"scale"     binding:"omitempty,eq=minute|eq=hour|eq=day"`
+	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
+	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
+}
+
+// cache time of trading summaries of the last 24 hours
+const TickersCacheTime = time.Duration(60)
+
+// cache time of holders statistics
+const HoldersCacheTime = time.Duration(600)
+
 type CacheCoinsData struct {
 	Coins      []models.Coin
 	Pagination tools.Pagination
 }
 
-func getCoinsWithPagination(c *gin.Context, req GetCoinsRequest, pagination *tools.Pagination) []models.Coin {
+func getCoinsWithPagination(c *gin.Context, req GetCoinsRequest, pagination *tools.Pagination) ([]models.Coin, error) {
 	explorer := c.MustGet("explorer").(*core.Explorer)
-	var data []models.Coin
 
 	var field, orderBy *string
-	if req.Filter != nil && helpers.IsModelsContain(*req.Filter, []string{"crr", "volume", "reserve_balance", "symbol",
-		"price", "capitalization", "delegated"}) {
+	if req.Filter != nil && helpers.IsModelsContain(*req.Filter, SortFields) {
 		field = req.Filter
 	}
 
@@ -51,28 +93,16 @@ func getCoinsWithPagination(c *gin.Context, req GetCoinsRequest, pagination *too
 		orderBy = req.OrderBy
 	}
 
-	getCoins := func() []models.Coin {
-		return explorer.CoinRepository.GetPaginated(pagination, field, orderBy, req.Symbol)
-	}
-
-	// cache last blocks
-	if pagination.GetCurrentPage() == 1 && pagination.GetPerPage() == tools.DefaultLimit {
-		//cached := explorer.Cache.Get("coins", func() interface{} {
-		//	return CacheCoinsData{getCoins(), pagination}
-		//}, CacheCoinsCount).(CacheCoinsData)
-		cached := CacheCoinsData{getCoins(), *pagination}
-		data = cached.Coins
-		*pagination = cached.Pagination
-	} else {
-		data = getCoins()
-	}
-
-	return data
+	//cached, err := explorer.Cache.Get("coins", func() (interface{}, error) {
+	//	coins, err := explorer.CoinRepository.GetPaginated(pagination, field, orderBy, req.Symbol)
+	//	return CacheCoinsData{coins, *pagination}, err
+	//}, CacheCoinsCount)
+	return explorer.CoinRepository.GetPaginated(pagination, field, orderBy, req.Symbol)
 }
 
 // Get list of coins
 func GetCoins(c *gin.Context) {
-	//explorer := c.MustGet("explorer").(*core.Explorer)
+	explorer := c.MustGet("explorer").(*core.Explorer)
 
 	// validate request
 	var request GetCoinsRequest
@@ -82,9 +112,12 @@ func GetCoins(c *gin.Context) {
 		return
 	}
 
-	var data []models.Coin
 	pagination := tools.NewPagination(c.Request)
-	data = getCoinsWithPagination(c, request, &pagination)
+	data, err := getCoinsWithPagination(c, request, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	// make response as empty array if no models found
 	if len(data) == 0 {
@@ -93,7 +126,12 @@ func GetCoins(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(data, coins.Resource{}, pagination))
+	tickers, rates := getTickers(explorer), explorer.Market.Rates()
+
+	c.JSON(http.StatusOK, resource.TransformPaginatedCollectionWithCallback(data, coins.Resource{}, pagination,
+		func(model resource.ParamInterface) resource.ParamsInterface {
+			return resource.ParamsInterface{coins.Params{Ticker: tickers[model.(models.Coin).Symbol], Rates: rates}}
+		}))
 }
 
 // Get coin detail
@@ -109,16 +147,16 @@ func GetCoinBySymbol(c *gin.Context) {
 	}
 
 	// fetch coin by symbol
-	coin := explorer.CoinRepository.GetBySymbol(request.Symbol)
-
-	// check coin to existing
-	if coin == nil {
-		errors.SetErrorResponse(http.StatusNotFound, http.StatusNotFound, "Coin not found.", c)
+	coin, err := explorer.CoinRepository.GetBySymbol(request.Symbol)
+	if err != nil {
+		c.Error(errors.WithNotFoundMessage(err, "Coin not found."))
 		return
 	}
 
+	params := coins.Params{Ticker: getTickers(explorer)[coin.Symbol], Rates: explorer.Market.Rates()}
+
 	c.JSON(http.StatusOK, gin.H{
-		"data": new(coins.Resource).Transform(*coin),
+		"data": new(coins.Resource).Transform(*coin, params),
 	})
 }
 
@@ -134,9 +172,21 @@ func GetTransactions(c *gin.Context) {
 		return
 	}
 
+	// validate request query
+	var filter transaction.FilterRequest
+	err = c.ShouldBindQuery(&filter)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
 	// fetch data
 	pagination := tools.NewPagination(c.Request)
-	txs := explorer.TransactionRepository.GetPaginatedTxsByCoin(request.Symbol, &pagination)
+	txs, err := explorer.TransactionRepository.GetPaginatedTxsByCoin(request.Symbol, filter.Filter(), &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(txs, transaction.ResourceTransactionOutput{}, pagination))
 }
@@ -154,13 +204,18 @@ func GetValidators(c *gin.Context) {
 	}
 
 	pagination := tools.NewPagination(c.Request)
-	data := explorer.ValidatorRepository.GetValidatorsBySymbol(request.Symbol, &pagination)
+	data, err := explorer.ValidatorRepository.GetValidatorsBySymbol(request.Symbol, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	// check validator to existing
 	if data == nil {
-		errors.SetErrorResponse(http.StatusNotFound, http.StatusNotFound, "Validator not found.", c)
+		c.Error(errors.NewNotFound("Validator not found."))
 		return
 	}
+
 	c.JSON(http.StatusOK,
 		resource.TransformPaginatedCollection(data, validator.ResourceWithValidators{}, pagination),
 	)
@@ -177,8 +232,18 @@ func GetAddressBalances(c *gin.Context) {
 		return
 	}
 
+	var query GetAddressBalancesRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
 	pagination := tools.NewPagination(c.Request)
-	balances := explorer.AddressRepository.GetBalancesByCoinSymbol(request.Symbol, &pagination)
+	balances, err := explorer.AddressRepository.GetBalancesByCoinSymbol(request.Symbol, query.OrderBy, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK,
 		resource.TransformPaginatedCollection(balances, balance.ResourceCoinAddressBalances{}, pagination),
@@ -198,14 +263,281 @@ func GetDelegators(c *gin.Context) {
 	}
 
 	pagination := tools.NewPagination(c.Request)
-	data := explorer.StakeRepository.GetPaginatedStakeForCoin(request.Symbol, &pagination)
+	data, err := explorer.StakeRepository.GetPaginatedStakeForCoin(request.Symbol, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	// check validator to existing
 	if data == nil {
-		errors.SetErrorResponse(http.StatusNotFound, http.StatusNotFound, "Validator not found.", c)
+		c.Error(errors.NewNotFound("Validator not found."))
 		return
 	}
+
 	c.JSON(http.StatusOK,
 		resource.TransformPaginatedCollection(data, stake.ResourceStakeDelegation{}, pagination),
 	)
 }
+
+// Estimate buying or selling of coin for another coin, the base coin by default
+func GetEstimate(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetCoinBySymbolRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query EstimateRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	amount, ok := new(big.Int).SetString(helpers.Noah2QNoahStr(query.Amount), 10)
+	if !ok || amount.Sign() <= 0 {
+		c.Error(errors.NewInvalidInput("Amount must be positive.", nil))
+		return
+	}
+
+	// symbols are case insensitive
+	symbol := strings.ToUpper(request.Symbol)
+	other := explorer.Environment.BaseCoin
+	if query.Coin != nil {
+		other = strings.ToUpper(*query.Coin)
+	}
+
+	if other == symbol {
+		c.Error(errors.NewInvalidInput("Coins to sell and to buy must differ.", nil))
+		return
+	}
+
+	curve, err := getCurve(explorer, symbol)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	otherCurve, err := getCurve(explorer, other)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// the amount is always in the requested coin
+	var estimate *coins.Estimate
+	params := coins.EstimateParams{CoinToSell: other, CoinToBuy: symbol}
+	if query.Type == "buy" {
+		estimate, err = coins.Conversion{Sell: otherCurve, Buy: curve}.EstimateBuy(amount)
+	} else {
+		params = coins.EstimateParams{CoinToSell: symbol, CoinToBuy: other}
+		estimate, err = coins.Conversion{Sell: curve, Buy: otherCurve}.EstimateSell(amount)
+	}
+
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": new(coins.EstimateResource).Transform(*estimate, params),
+	})
+}
+
+// Get price candles of coin trades against the base coin
+func GetCandles(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetCoinBySymbolRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query GetCandlesRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// set defaults
+	scale := config.DefaultStatisticsScale
+	if query.Scale != nil {
+		scale = *query.Scale
+	}
+
+	startTime := helpers.StartOfTheDay(time.Now().AddDate(0, 0, config.DefaultStatisticsDayDelta))
+	if query.StartTime != nil {
+		startTime, _ = helpers.ParseTimestamp(*query.StartTime)
+	}
+
+	var endTime *time.Time
+	if query.EndTime != nil {
+		t, _ := helpers.ParseTimestamp(*query.EndTime)
+		endTime = &t
+	}
+
+	candles, err := explorer.CandleRepository.GetCandles(request.Symbol, scale, startTime, endTime)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resource.TransformCollection(candles, candle.Resource{}),
+	})
+}
+
+// Get holders count, shares of the largest holders, gini index and histogram of balances of coin
+func GetHoldersStatistics(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetCoinBySymbolRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// only existing coins are cached
+	coin, err := explorer.CoinRepository.GetBySymbol(strings.ToUpper(request.Symbol))
+	if err != nil {
+		c.Error(errors.WithNotFoundMessage(err, "Coin not found."))
+		return
+	}
+
+	statistics, err := explorer.Cache.Get("coin_holders_"+coin.Symbol, func() (interface{}, error) {
+		distribution, err := explorer.HolderRepository.GetDistribution(coin.Symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		buckets, err := explorer.HolderRepository.GetBuckets(coin.Symbol)
+		if err != nil {
+			return nil, err
+		}
+
+		return holder.Statistics{Distribution: *distribution, Buckets: buckets}, nil
+	}, HoldersCacheTime)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": new(holder.StatisticsResource).Transform(statistics),
+	})
+}
+
+// Get daily history of holders count of coin
+func GetHoldersHistory(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetCoinBySymbolRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query GetHoldersHistoryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	snapshots, err := explorer.HolderRepository.GetSnapshots(request.Symbol, query.StartTime, query.EndTime)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resource.TransformCollection(snapshots, holder.SnapshotResource{}),
+	})
+}
+
+// Get list of addresses ranked by balances and stakes in coin
+func GetRichList(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetCoinBySymbolRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	pagination := tools.NewPagination(c.Request)
+	entries, err := explorer.RichListRepository.GetPaginatedByCoin(request.Symbol, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(entries, rich_list.CoinResource{}, pagination))
+}
+
+// Get rank of address in the list of addresses ranked by coin
+func GetRichListAddress(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetCoinAddressRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	entry, err := explorer.RichListRepository.GetByCoinAndAddress(request.Symbol, helpers.RemoveNoahPrefix(request.Address))
+	if err != nil {
+		c.Error(errors.WithNotFoundMessage(err, "Address is not ranked."))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": new(rich_list.CoinResource).Transform(*entry),
+	})
+}
+
+// Get trading summaries of the last 24 hours by coin symbols,
+// coins are still served without summaries when candles are unavailable
+func getTickers(explorer *core.Explorer) map[string]candle.Ticker {
+	tickers, err := explorer.Cache.Get("coin_tickers", func() (interface{}, error) {
+		data, err := explorer.CandleRepository.GetTickers(time.Now().Add(-24 * time.Hour))
+		if err != nil {
+			return nil, err
+		}
+
+		tickers := make(map[string]candle.Ticker, len(data))
+		for _, ticker := range data {
+			tickers[ticker.Coin] = ticker
+		}
+
+		return tickers, nil
+	}, TickersCacheTime)
+	if err != nil {
+		log.Printf("coins: failed to get tickers: %s", err)
+		return nil
+	}
+
+	return tickers.(map[string]candle.Ticker)
+}
+
+// Get bonding curve of coin, the base coin has no curve
+func getCurve(explorer *core.Explorer, symbol string) (*coins.Curve, error) {
+	if symbol == explorer.Environment.BaseCoin {
+		return nil, nil
+	}
+
+	coin, err := explorer.CoinRepository.GetBySymbol(symbol)
+	if err != nil {
+		return nil, errors.WithNotFoundMessage(err, "Coin not found.")
+	}
+
+	return coins.NewCurve(*coin)
+}
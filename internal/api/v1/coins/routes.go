@@ -14,6 +14,12 @@ func ApplyRoutes(r *gin.RouterGroup) {
 		coins.GET("/:symbol/validators", GetValidators)
 		coins.GET("/:symbol/balances", GetAddressBalances)
 		coins.GET("/:symbol/delegators", GetDelegators)
+		coins.GET("/:symbol/estimate", GetEstimate)
+		coins.GET("/:symbol/candles", GetCandles)
+		coins.GET("/:symbol/statistics/holders", GetHoldersStatistics)
+		coins.GET("/:symbol/statistics/holders/history", GetHoldersHistory)
+		coins.GET("/:symbol/rich-list", GetRichList)
+		coins.GET("/:symbol/rich-list/:address", GetRichListAddress)
 
 	}
 }
@@ -0,0 +1,255 @@
+package docs
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/address"
+	addressTimeline "github.com/noah-blockchain/noah-explorer-api/internal/address/timeline"
+	"github.com/noah-blockchain/noah-explorer-api/internal/aggregated_reward"
+	"github.com/noah-blockchain/noah-explorer-api/internal/balance"
+	"github.com/noah-blockchain/noah-explorer-api/internal/block_validator"
+	"github.com/noah-blockchain/noah-explorer-api/internal/blocks"
+	"github.com/noah-blockchain/noah-explorer-api/internal/decentralization"
+	"github.com/noah-blockchain/noah-explorer-api/internal/delegation"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/holder"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+	"github.com/noah-blockchain/noah-explorer-api/internal/reward"
+	"github.com/noah-blockchain/noah-explorer-api/internal/slash"
+	"github.com/noah-blockchain/noah-explorer-api/internal/stake"
+	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
+	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
+	"github.com/noah-blockchain/noah-explorer-api/internal/validator"
+	"github.com/noah-blockchain/noah-explorer-api/internal/validator/meta"
+	"github.com/noah-blockchain/noah-explorer-api/internal/validator/timeline"
+)
+
+const jsonContentType = "application/json"
+
+var parameterDescriptions = map[string]string{
+	"page":         "Page number",
+	"limit":        fmt.Sprintf("Count of items per page, %d by default", tools.DefaultLimit),
+	"startblock":   "Height of the first block of range",
+	"endblock":     "Height of the last block of range",
+	"filter":       "Field to order by",
+	"order_by":     "Order direction",
+	"scale":        "Time scale of chart",
+	"symbol":       "Coin symbol",
+	"height":       "Block height",
+	"after":        "Id of the last row of the previous page, switches list to cursor pagination",
+	"coins[]":      "Coin symbols",
+	"addresses[]":  "Noah addresses with NOAHx prefix",
+	"validators[]": "Validator public keys with Np prefix",
+	"q":            "Address, transaction hash, block height, validator public key or name, coin symbol",
+	"type[]":       "Transaction types: 1 - send, 2 - sell coin, 3 - sell all coin, 4 - buy coin, 5 - create coin, 6 - declare candidacy, 7 - delegate, 8 - unbond, 9 - redeem check, 10 - set candidate online, 11 - set candidate offline, 12 - create multisig, 13 - multisend, 14 - edit candidate",
+	"coin":         "Coin symbol of transaction output or data, of balance chart, of stake or to convert with",
+	"gas_coin":     "Coin of transaction fee",
+	"direction":    "Direction of transactions relative to the addresses",
+	"min_value":    "Minimal value of transaction output or data in coins",
+	"max_value":    "Maximal value of transaction output or data in coins",
+	"format":       "Format of export, csv by default",
+	"amount":       "Amount in coins",
+	"role":         "Reward role",
+	"type":         "Conversion type, buy or sell of the coin",
+	"time":         "Time to get the last market price by, the current price by default",
+	"at_block":     "Height of the block to get balances at, current balances by default",
+}
+
+// Build OpenAPI document of api v1 endpoints
+func NewDocument() *Document {
+	registry := newResourceSchemaRegistry()
+
+	document := &Document{
+		OpenApi: "3.0.2",
+		Info: Info{
+			Title:       "Noah Explorer API",
+			Description: "Blocks, transactions, coins, addresses and validators of the Noah blockchain. Amounts are in coins, not in qNoah. Requests are rate limited by IP, API key raises the limits and is passed in X-API-Key header or api_key query parameter.",
+			Version:     "1.0",
+		},
+		Servers: []Server{{Url: "/api/v1"}},
+		Paths:   make(map[string]*PathItem),
+	}
+
+	tags := make(map[string]bool)
+	for _, endpoint := range Endpoints {
+		if !tags[endpoint.Tag] {
+			tags[endpoint.Tag] = true
+			document.Tags = append(document.Tags, Tag{endpoint.Tag})
+		}
+
+		document.Paths[openApiPath(endpoint.Path)] = &PathItem{Get: newOperation(endpoint, registry)}
+	}
+
+	document.Components.Schemas = registry.schemas
+	return document
+}
+
+// Registry which knows resources behind resource.Interface fields
+func newResourceSchemaRegistry() *schemaRegistry {
+	registry := newSchemaRegistry()
+
+	metaSchema := registry.Of(meta.Resource{})
+	for _, owner := range []interface{}{
+		blocks.ValidatorResource{}, reward.Resource{}, slash.Resource{},
+		aggregated_reward.Resource{}, delegation.Resource{}, block_validator.SetChangeResource{},
+	} {
+		registry.setField(owner, "ValidatorMeta", metaSchema)
+	}
+
+	registry.setField(validator.Resource{}, "Meta", metaSchema)
+	registry.setField(validator.ResourceAggregator{}, "Meta", metaSchema)
+	registry.setField(validator.Resource{}, "DelegatorList", registry.Of([]stake.Resource{}))
+	registry.setField(blocks.Resource{}, "Validators", registry.Of([]blocks.ValidatorResource{}))
+	registry.setField(address.Resource{}, "Balances", registry.Of([]balance.Resource{}))
+
+	dataSchema := transactionDataSchema(registry)
+	registry.setField(transaction.Resource{}, "Data", dataSchema)
+	registry.setField(transaction.ResourceTransactionOutput{}, "Data", dataSchema)
+
+	metricsSchema := registry.Of(decentralization.MetricsResource{})
+	registry.setField(decentralization.ValidatorDelegatorsResource{}, "Delegators", metricsSchema)
+	registry.setField(decentralization.Resource{}, "Validators", metricsSchema)
+	registry.setField(decentralization.Resource{}, "Delegators", registry.Of([]decentralization.ValidatorDelegatorsResource{}))
+
+	registry.setField(holder.StatisticsResource{}, "Buckets", registry.Of([]holder.BucketResource{}))
+	registry.setField(block_validator.StatisticsResource{}, "Chart", registry.Of([]block_validator.ChartResource{}))
+	registry.setField(timeline.Resource{}, "Data", &Schema{
+		Description: "Transaction of transaction entries, slash of slash entries, null of validator set entries and exits",
+		Nullable:    true,
+		OneOf:       []*Schema{registry.Of(transaction.Resource{}), registry.Of(timeline.SlashResource{})},
+	})
+	registry.setField(addressTimeline.Resource{}, "Deltas", registry.Of([]addressTimeline.DeltaResource{}))
+	registry.setField(addressTimeline.Resource{}, "Data", &Schema{
+		Description: "Transaction of transaction entries, rewards sum of reward entries, slash of slash entries, returned stake of unbond return entries",
+		OneOf: []*Schema{
+			registry.Of(transaction.Resource{}),
+			registry.Of(addressTimeline.RewardResource{}),
+			registry.Of(slash.Resource{}),
+			registry.Of(addressTimeline.UnbondReturnResource{}),
+		},
+	})
+
+	return registry
+}
+
+// Data of transaction is one of data resources defined by transaction type
+func transactionDataSchema(registry *schemaRegistry) *Schema {
+	resources := transaction.DataResources()
+
+	types := make([]int, 0, len(resources))
+	for txType := range resources {
+		types = append(types, int(txType))
+	}
+	sort.Ints(types)
+
+	schema := &Schema{Description: "Data by transaction type:"}
+	added := make(map[string]bool)
+	for _, txType := range types {
+		dataSchema := registry.Of(resources[uint8(txType)])
+		schema.Description += fmt.Sprintf(" %d - %s;", txType, dataSchema.Ref[len("#/components/schemas/"):])
+
+		if !added[dataSchema.Ref] {
+			added[dataSchema.Ref] = true
+			schema.OneOf = append(schema.OneOf, dataSchema)
+		}
+	}
+
+	return schema
+}
+
+func newOperation(endpoint Endpoint, registry *schemaRegistry) *Operation {
+	operation := &Operation{
+		OperationId: operationId("GET", endpoint.Path),
+		Summary:     endpoint.Summary,
+		Tags:        []string{endpoint.Tag},
+		Parameters:  append(requestParameters(endpoint.Uri), requestParameters(endpoint.Query)...),
+		Responses: map[string]*Response{
+			"default": jsonResponse("Error", registry.Of(errors.Response{})),
+		},
+	}
+
+	if endpoint.Envelope == EnvelopePaginated {
+		operation.Parameters = appendPaginationParameters(operation.Parameters)
+	}
+
+	for i, param := range operation.Parameters {
+		if enum, ok := endpoint.Enums[param.Name]; ok {
+			operation.Parameters[i].Schema.Enum = enum
+		}
+	}
+
+	switch endpoint.Envelope {
+	case EnvelopeStream:
+		operation.Responses["200"] = &Response{
+			Description: "Stream of events, data of block and transaction events is the same as in block and transaction endpoints",
+			Content:     map[string]MediaType{"text/event-stream": {Schema: &Schema{Type: "string"}}},
+		}
+	case EnvelopeRaw:
+		operation.Responses["200"] = &Response{Description: "Successful response"}
+	case EnvelopeExport:
+		operation.Responses["200"] = &Response{
+			Description: "Rows as CSV with header or as newline-delimited JSON objects",
+			Content: map[string]MediaType{
+				"text/csv":             {Schema: &Schema{Type: "string"}},
+				"application/x-ndjson": {Schema: registry.Of(endpoint.Response)},
+			},
+		}
+	default:
+		operation.Responses["200"] = jsonResponse("Successful response", envelopeSchema(endpoint.Envelope, endpoint.Response, registry))
+	}
+
+	if endpoint.Partial != nil {
+		operation.Responses["206"] = jsonResponse("Partial content", envelopeSchema(EnvelopeItem, endpoint.Partial, registry))
+	}
+
+	return operation
+}
+
+func envelopeSchema(envelope Envelope, response interface{}, registry *schemaRegistry) *Schema {
+	item := registry.Of(response)
+
+	switch envelope {
+	case EnvelopeList:
+		return dataSchema(&Schema{Type: "array", Items: item})
+	case EnvelopePaginated:
+		schema := dataSchema(&Schema{Type: "array", Items: item})
+		schema.Properties["links"] = registry.Of(resource.PaginationLinksResource{})
+		schema.Properties["meta"] = registry.Of(resource.PaginationMetaResource{})
+		return schema
+	}
+
+	return dataSchema(item)
+}
+
+func dataSchema(data *Schema) *Schema {
+	return &Schema{Type: "object", Properties: map[string]*Schema{"data": data}}
+}
+
+func jsonResponse(description string, schema *Schema) *Response {
+	return &Response{
+		Description: description,
+		Content:     map[string]MediaType{jsonContentType: {Schema: schema}},
+	}
+}
+
+// Add page and limit parameters unless they are bound by request struct
+func appendPaginationParameters(params []Parameter) []Parameter {
+	for _, name := range []string{"page", "limit"} {
+		bound := false
+		for _, param := range params {
+			bound = bound || param.Name == name
+		}
+
+		if !bound {
+			params = append(params, Parameter{
+				Name:        name,
+				In:          "query",
+				Description: parameterDescriptions[name],
+				Schema:      &Schema{Type: "string", Pattern: "^[0-9]+$"},
+			})
+		}
+	}
+
+	return params
+}
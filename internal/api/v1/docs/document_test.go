@@ -0,0 +1,38 @@
+package docs
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDocumentDescribesAllProperties(t *testing.T) {
+	document := NewDocument()
+
+	if _, err := json.Marshal(document); err != nil {
+		t.Fatalf("Document marshaling failed: %s", err)
+	}
+
+	// resource interface fields must be described by known resources
+	for name, schema := range document.Components.Schemas {
+		for property, propertySchema := range schema.Properties {
+			if propertySchema.Type == "" && propertySchema.Ref == "" && propertySchema.OneOf == nil {
+				t.Errorf("Property %s of %s has no schema", property, name)
+			}
+		}
+	}
+}
+
+func TestTransactionDataSchema(t *testing.T) {
+	document := NewDocument()
+
+	data := document.Components.Schemas["TransactionResource"].Properties["data"]
+	if len(data.OneOf) == 0 {
+		t.Fatal("Transaction data must be one of data resources")
+	}
+
+	for _, schema := range data.OneOf {
+		if _, ok := document.Components.Schemas[schema.Ref[len("#/components/schemas/"):]]; !ok {
+			t.Errorf("Schema %s is not defined", schema.Ref)
+		}
+	}
+}
@@ -0,0 +1,575 @@
+package docs
+
+import (
+	"fmt"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/address"
+	addressTimeline "github.com/noah-blockchain/noah-explorer-api/internal/address/timeline"
+	"github.com/noah-blockchain/noah-explorer-api/internal/aggregated_reward"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/addresses"
+	apiBlocks "github.com/noah-blockchain/noah-explorer-api/internal/api/v1/blocks"
+	apiCoins "github.com/noah-blockchain/noah-explorer-api/internal/api/v1/coins"
+	apiMarket "github.com/noah-blockchain/noah-explorer-api/internal/api/v1/market"
+	apiSearch "github.com/noah-blockchain/noah-explorer-api/internal/api/v1/search"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/statistics"
+	apiStream "github.com/noah-blockchain/noah-explorer-api/internal/api/v1/stream"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/transactions"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/validators"
+	"github.com/noah-blockchain/noah-explorer-api/internal/balance"
+	"github.com/noah-blockchain/noah-explorer-api/internal/block_validator"
+	"github.com/noah-blockchain/noah-explorer-api/internal/blocks"
+	"github.com/noah-blockchain/noah-explorer-api/internal/candle"
+	"github.com/noah-blockchain/noah-explorer-api/internal/chart"
+	"github.com/noah-blockchain/noah-explorer-api/internal/coins"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/decentralization"
+	"github.com/noah-blockchain/noah-explorer-api/internal/delegation"
+	"github.com/noah-blockchain/noah-explorer-api/internal/holder"
+	"github.com/noah-blockchain/noah-explorer-api/internal/invalid_transaction"
+	"github.com/noah-blockchain/noah-explorer-api/internal/market"
+	"github.com/noah-blockchain/noah-explorer-api/internal/reward"
+	"github.com/noah-blockchain/noah-explorer-api/internal/rich_list"
+	"github.com/noah-blockchain/noah-explorer-api/internal/search"
+	"github.com/noah-blockchain/noah-explorer-api/internal/slash"
+	"github.com/noah-blockchain/noah-explorer-api/internal/stake"
+	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
+	"github.com/noah-blockchain/noah-explorer-api/internal/validator"
+	"github.com/noah-blockchain/noah-explorer-api/internal/validator/timeline"
+)
+
+// How the response resource is wrapped
+type Envelope int
+
+const (
+	EnvelopeItem      Envelope = iota // {"data": resource}
+	EnvelopeList                      // {"data": [resource]}
+	EnvelopePaginated                 // {"data": [resource], "links": ..., "meta": ...}
+	EnvelopeStream                    // text/event-stream
+	EnvelopeRaw                       // resource as is
+	EnvelopeExport                    // rows of resource as CSV or NDJSON
+)
+
+type Endpoint struct {
+	Path     string // gin route path relative to /api/v1
+	Tag      string
+	Summary  string
+	Uri      interface{}         // request struct bound by ShouldBindUri
+	Query    interface{}         // request struct bound by ShouldBindQuery
+	Enums    map[string][]string // allowed values of query parameters checked by handler
+	Response interface{}
+	Partial  interface{} // resource responded with status 206
+	Envelope Envelope
+}
+
+var orderDirections = []string{"ASC", "DESC"}
+
+// Endpoints of api v1, every route of ApplyRoutes must be described here
+var Endpoints = []Endpoint{
+	{
+		Path:     "/blocks",
+		Tag:      "Blocks",
+		Summary:  "Get list of blocks",
+		Query:    apiBlocks.GetBlocksRequest{},
+		Response: blocks.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/blocks/:height",
+		Tag:      "Blocks",
+		Summary:  "Get block by height",
+		Uri:      apiBlocks.GetBlockRequest{},
+		Response: blocks.Resource{},
+	},
+	{
+		Path:     "/blocks/:height/transactions",
+		Tag:      "Blocks",
+		Summary:  "Get list of transactions by block height",
+		Uri:      apiBlocks.GetBlockRequest{},
+		Query:    apiBlocks.GetBlocksRequest{},
+		Response: transaction.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/blocks/:height/validators",
+		Tag:      "Blocks",
+		Summary:  "Get validator set of block with signatures",
+		Uri:      apiBlocks.GetBlockRequest{},
+		Response: blocks.ValidatorResource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/coins",
+		Tag:      "Coins",
+		Summary:  "Get list of coins",
+		Query:    apiCoins.GetCoinsRequest{},
+		Enums:    map[string][]string{"filter": apiCoins.SortFields, "order_by": orderDirections},
+		Response: coins.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/coins/:symbol",
+		Tag:      "Coins",
+		Summary:  "Get coin by symbol",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Response: coins.Resource{},
+	},
+	{
+		Path:     "/coins/:symbol/transactions",
+		Tag:      "Coins",
+		Summary:  "Get list of transaction outputs by coin",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Query:    transaction.FilterRequest{},
+		Response: transaction.ResourceTransactionOutput{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/coins/:symbol/validators",
+		Tag:      "Coins",
+		Summary:  "Get list of validators with stakes in coin",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Response: validator.ResourceWithValidators{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/coins/:symbol/balances",
+		Tag:      "Coins",
+		Summary:  "Get list of address balances of coin",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Query:    apiCoins.GetAddressBalancesRequest{},
+		Response: balance.ResourceCoinAddressBalances{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/coins/:symbol/delegators",
+		Tag:      "Coins",
+		Summary:  "Get list of stakes delegated in coin",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Response: stake.ResourceStakeDelegation{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/coins/:symbol/estimate",
+		Tag:      "Coins",
+		Summary:  "Estimate buying or selling of coin by its bonding curve",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Query:    apiCoins.EstimateRequest{},
+		Response: coins.EstimateResource{},
+		Envelope: EnvelopeItem,
+	},
+	{
+		Path:     "/coins/:symbol/candles",
+		Tag:      "Coins",
+		Summary:  "Get open, high, low and close prices and volume in the base coin of coin trades against the base coin",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Query:    apiCoins.GetCandlesRequest{},
+		Response: candle.Resource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/coins/:symbol/statistics/holders",
+		Tag:      "Coins",
+		Summary:  "Get holders count, shares of the top 10 and top 100 holders, gini index and histogram of balances by decimal magnitudes of coin",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Response: holder.StatisticsResource{},
+		Envelope: EnvelopeItem,
+	},
+	{
+		Path:     "/coins/:symbol/statistics/holders/history",
+		Tag:      "Coins",
+		Summary:  "Get daily history of holders count of coin",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Query:    apiCoins.GetHoldersHistoryRequest{},
+		Response: holder.SnapshotResource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/coins/:symbol/rich-list",
+		Tag:      "Coins",
+		Summary:  "Get list of addresses ranked by balances and stakes in coin",
+		Uri:      apiCoins.GetCoinBySymbolRequest{},
+		Response: rich_list.CoinResource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/coins/:symbol/rich-list/:address",
+		Tag:      "Coins",
+		Summary:  "Get rank of address in the list of addresses ranked by coin",
+		Uri:      apiCoins.GetCoinAddressRequest{},
+		Response: rich_list.CoinResource{},
+		Envelope: EnvelopeItem,
+	},
+	{
+		Path:     "/addresses",
+		Tag:      "Addresses",
+		Summary:  "Get balances of addresses",
+		Query:    addresses.GetAddressesRequest{},
+		Response: address.Resource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/addresses-top",
+		Tag:      "Addresses",
+		Summary:  fmt.Sprintf("Get list of addresses ranked by base coin value of balances and stakes, refreshed every %d seconds", config.RichListRefreshPeriodInSec),
+		Response: rich_list.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/addresses-top/:address",
+		Tag:      "Addresses",
+		Summary:  "Get rank of address in the list of top addresses",
+		Uri:      addresses.GetAddressRequest{},
+		Response: rich_list.Resource{},
+		Envelope: EnvelopeItem,
+	},
+	{
+		Path:     "/addresses/:address",
+		Tag:      "Addresses",
+		Summary:  "Get balances of address",
+		Uri:      addresses.GetAddressRequest{},
+		Response: address.Resource{},
+	},
+	{
+		Path:     "/addresses/:address/transactions",
+		Tag:      "Addresses",
+		Summary:  "Get list of transactions by address",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.TransactionsQueryRequest{},
+		Response: transaction.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/addresses/:address/timeline",
+		Tag:      "Addresses",
+		Summary:  "Get transfers, conversions, delegations, unbonds and their returns, check redemptions, rewards summed by blocks and slashes of address with balance changes from the latest",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.TimelineQueryRequest{},
+		Response: addressTimeline.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/addresses/:address/balances",
+		Tag:      "Addresses",
+		Summary:  "Get balances of address, at the block if requested",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.BalancesQueryRequest{},
+		Response: balance.Resource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/addresses/:address/events/rewards",
+		Tag:      "Addresses",
+		Summary:  "Get list of rewards by address",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.FilterQueryRequest{},
+		Response: reward.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/addresses/:address/events/slashes",
+		Tag:      "Addresses",
+		Summary:  "Get list of slashes by address",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.FilterQueryRequest{},
+		Response: slash.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/addresses/:address/delegations",
+		Tag:      "Addresses",
+		Summary:  "Get list of stakes delegated by address",
+		Uri:      addresses.GetAddressRequest{},
+		Response: delegation.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/addresses/:address/statistics/rewards",
+		Tag:      "Addresses",
+		Summary:  "Get daily rewards chart of address",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.StatisticsQueryRequest{},
+		Response: chart.RewardResource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/addresses/:address/statistics/balances",
+		Tag:      "Addresses",
+		Summary:  "Get balance chart of address by coin, base coin by default",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.BalancesStatisticsQueryRequest{},
+		Response: chart.BalanceResource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/addresses/:address/events/rewards/aggregated",
+		Tag:      "Addresses",
+		Summary:  "Get list of daily aggregated rewards by address",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.FilterQueryRequest{},
+		Response: aggregated_reward.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/addresses/:address/export/transactions",
+		Tag:      "Addresses",
+		Summary:  "Export all transactions of address as CSV or NDJSON, one row per transfer including multisend outputs to the address",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.ExportQueryRequest{},
+		Response: transaction.TransferResource{},
+		Envelope: EnvelopeExport,
+	},
+	{
+		Path:     "/addresses/:address/export/rewards",
+		Tag:      "Addresses",
+		Summary:  "Export all rewards of address as CSV or NDJSON",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.ExportQueryRequest{},
+		Response: reward.ExportResource{},
+		Envelope: EnvelopeExport,
+	},
+	{
+		Path:     "/addresses/:address/export/slashes",
+		Tag:      "Addresses",
+		Summary:  "Export all slashes of address as CSV or NDJSON",
+		Uri:      addresses.GetAddressRequest{},
+		Query:    addresses.ExportQueryRequest{},
+		Response: slash.ExportResource{},
+		Envelope: EnvelopeExport,
+	},
+	{
+		Path:     "/transactions",
+		Tag:      "Transactions",
+		Summary:  "Get list of transactions",
+		Query:    transactions.GetTransactionsRequest{},
+		Response: transaction.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/transactions/:hash",
+		Tag:      "Transactions",
+		Summary:  "Get transaction by hash, invalid transactions are responded with status 206",
+		Uri:      transactions.GetTransactionRequest{},
+		Response: transaction.Resource{},
+		Partial:  invalid_transaction.Resource{},
+	},
+	{
+		Path:     "/validators",
+		Tag:      "Validators",
+		Summary:  "Get list of validators",
+		Query:    validators.GetAggregatedValidatorRequest{},
+		Enums:    map[string][]string{"filter": validators.SortFields, "order_by": orderDirections},
+		Response: validator.ResourceAggregator{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/validators/:publicKey",
+		Tag:      "Validators",
+		Summary:  "Get validator by public key",
+		Uri:      validators.GetValidatorRequest{},
+		Response: validator.Resource{},
+	},
+	{
+		Path:     "/validators/:publicKey/transactions",
+		Tag:      "Validators",
+		Summary:  "Get list of transactions by validator",
+		Uri:      validators.GetValidatorRequest{},
+		Query:    validators.GetValidatorTransactionsRequest{},
+		Response: transaction.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/validators/:publicKey/delegators",
+		Tag:      "Validators",
+		Summary:  "Get list of validator delegators",
+		Uri:      validators.GetValidatorRequest{},
+		Response: stake.ResourceDelegatorsForValidator{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/validators/:publicKey/calculator",
+		Tag:      "Validators",
+		Summary:  "Estimate delegator rewards of stake by validator yield of the last 30 full days or since its creation",
+		Uri:      validators.GetValidatorRequest{},
+		Query:    validators.CalculatorRequest{},
+		Response: validator.CalculatorResource{},
+		Envelope: EnvelopeItem,
+	},
+	{
+		Path:     "/validators/:publicKey/blocks",
+		Tag:      "Validators",
+		Summary:  "Get list of blocks signed or missed by validator",
+		Uri:      validators.GetValidatorRequest{},
+		Query:    validators.FilterQueryRequest{},
+		Response: block_validator.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/validators/:publicKey/statistics/signing",
+		Tag:      "Validators",
+		Summary:  "Get signed and missed blocks chart and missed streaks of validator",
+		Uri:      validators.GetValidatorRequest{},
+		Query:    validators.SigningStatisticsRequest{},
+		Response: block_validator.StatisticsResource{},
+		Envelope: EnvelopeItem,
+	},
+	{
+		Path:     "/validators/:publicKey/events/slashes",
+		Tag:      "Validators",
+		Summary:  "Get list of slashes of validator delegators",
+		Uri:      validators.GetValidatorRequest{},
+		Query:    validators.FilterQueryRequest{},
+		Response: slash.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/validators/:publicKey/rewards/daily",
+		Tag:      "Validators",
+		Summary:  "Get daily rewards paid by validator by roles",
+		Uri:      validators.GetValidatorRequest{},
+		Query:    validators.RewardsQueryRequest{},
+		Response: reward.DailyResource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/validators/:publicKey/statistics/rewards",
+		Tag:      "Validators",
+		Summary:  "Get rewards chart of validator, of all roles by default",
+		Uri:      validators.GetValidatorRequest{},
+		Query:    validators.RewardsStatisticsRequest{},
+		Response: chart.RewardResource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/validators/:publicKey/timeline",
+		Tag:      "Validators",
+		Summary:  "Get lifecycle transactions, slashes and validator set entries and exits of validator from the latest",
+		Uri:      validators.GetValidatorRequest{},
+		Query:    validators.ValidatorTimelineRequest{},
+		Response: timeline.Resource{},
+		Envelope: EnvelopePaginated,
+	},
+	{
+		Path:     "/validators-set/changes",
+		Tag:      "Validators",
+		Summary:  fmt.Sprintf("Get entries to and exits from the validator set in blocks range, the last %d blocks by default", config.ValidatorSetChangesDefaultBlocks),
+		Query:    validators.ValidatorSetChangesRequest{},
+		Response: block_validator.SetChangeResource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/validators-set/threshold",
+		Tag:      "Validators",
+		Summary:  "Get size of the validator set and stake of the weakest candidate within it, null if there are free places",
+		Response: validator.ThresholdResource{},
+		Envelope: EnvelopeItem,
+	},
+	{
+		Path:     "/statistics/transactions",
+		Tag:      "Statistics",
+		Summary:  "Get transactions count chart",
+		Query:    statistics.GetTransactionsRequest{},
+		Response: chart.TransactionResource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/statistics/decentralization",
+		Tag:      "Statistics",
+		Summary:  fmt.Sprintf("Get Nakamoto coefficient, Gini index and share of the top %d of active validators stakes and of delegators stakes of each validator", config.DecentralizationTopCount),
+		Response: decentralization.Resource{},
+		Envelope: EnvelopeItem,
+	},
+	{
+		Path:     "/statistics/decentralization/history",
+		Tag:      "Statistics",
+		Summary:  "Get daily history of decentralization metrics of active validators",
+		Query:    statistics.GetDecentralizationHistoryRequest{},
+		Response: decentralization.SnapshotResource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/market/price",
+		Tag:      "Market",
+		Summary:  "Get the current market price of the base coin in fiat currency or the last price by the time",
+		Query:    apiMarket.GetPriceRequest{},
+		Response: market.Resource{},
+		Envelope: EnvelopeItem,
+	},
+	{
+		Path:     "/market/prices",
+		Tag:      "Market",
+		Summary:  "Get market prices of the base coin in fiat currency averaged by periods",
+		Query:    apiMarket.GetPricesRequest{},
+		Response: market.Resource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/status",
+		Tag:      "Status",
+		Summary:  "Get network status",
+		Response: statusResource{},
+	},
+	{
+		Path:     "/status-page",
+		Tag:      "Status",
+		Summary:  "Get network statistics for status page",
+		Response: statusPageResource{},
+	},
+	{
+		Path:     "/stream",
+		Tag:      "Stream",
+		Summary:  "Stream new blocks and transactions as server-sent events: block, transaction and ping",
+		Query:    apiStream.GetStreamRequest{},
+		Envelope: EnvelopeStream,
+	},
+	{
+		Path:     "/search",
+		Tag:      "Search",
+		Summary:  "Search address, transaction, block, validator or coin by identifier, coins and validators are also matched by symbol and name prefix",
+		Query:    apiSearch.SearchRequest{},
+		Response: search.Resource{},
+		Envelope: EnvelopeList,
+	},
+	{
+		Path:     "/openapi.json",
+		Tag:      "Docs",
+		Summary:  "Get OpenAPI specification",
+		Envelope: EnvelopeRaw,
+	},
+	{
+		Path:     "/docs",
+		Tag:      "Docs",
+		Summary:  "Browse API documentation",
+		Envelope: EnvelopeRaw,
+	},
+}
+
+// Response of status endpoint
+type statusResource struct {
+	LatestBlockHeight     uint64  `json:"latestBlockHeight"`
+	TotalTransactions     int     `json:"totalTransactions"`
+	AverageBlockTime      float64 `json:"averageBlockTime"`
+	LatestBlockTime       string  `json:"latestBlockTime"`
+	TransactionsPerSecond float64 `json:"transactionsPerSecond"`
+}
+
+// Response of status page endpoint
+type statusPageResource struct {
+	Status              string   `json:"status"`
+	NumberOfBlocks      uint64   `json:"numberOfBlocks"`
+	BlockSpeed24h       float64  `json:"blockSpeed24h"`
+	TxTotalCount        int      `json:"txTotalCount"`
+	Tx24hCount          int      `json:"tx24hCount"`
+	ActiveValidators    int      `json:"activeValidators"`
+	ActiveCandidates    int      `json:"activeCandidates"`
+	TotalDelegatedNoah  string   `json:"totalDelegatedNoah"`
+	CustomCoinsCount    uint     `json:"customCoinsCount"`
+	AverageTxCommission float64  `json:"averageTxCommission"`
+	TotalCommission     float64  `json:"totalCommission"`
+	CustomCoinsSum      string   `json:"customCoinsSum"`
+	NoahEmission        uint64   `json:"noahEmission"`
+	FreeFloatNoah       float64  `json:"freeFloatNoah"`
+	TxPerSecond         float64  `json:"txPerSecond"`
+	Uptime              float64  `json:"uptime"`
+	FiatCurrency        string   `json:"fiatCurrency"`
+	FiatPrice           *float64 `json:"fiatPrice"`
+	MarketCap           *float64 `json:"marketCap"`
+}
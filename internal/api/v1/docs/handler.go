@@ -0,0 +1,35 @@
+package docs
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	specification     []byte
+	specificationErr  error
+	specificationOnce sync.Once
+)
+
+// Get OpenAPI specification of api v1
+func GetOpenApi(c *gin.Context) {
+	// the document depends only on the code, so build it once
+	specificationOnce.Do(func() {
+		specification, specificationErr = json.Marshal(NewDocument())
+	})
+
+	if specificationErr != nil {
+		c.Error(specificationErr)
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json; charset=utf-8", specification)
+}
+
+// Get page browsing the OpenAPI specification
+func GetDocs(c *gin.Context) {
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(docsPage))
+}
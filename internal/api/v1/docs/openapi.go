@@ -0,0 +1,355 @@
+package docs
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type Document struct {
+	OpenApi    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Servers    []Server             `json:"servers"`
+	Tags       []Tag                `json:"tags"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	Version     string `json:"version"`
+}
+
+type Server struct {
+	Url string `json:"url"`
+}
+
+type Tag struct {
+	Name string `json:"name"`
+}
+
+type PathItem struct {
+	Get *Operation `json:"get,omitempty"`
+}
+
+type Operation struct {
+	OperationId string               `json:"operationId"`
+	Summary     string               `json:"summary"`
+	Tags        []string             `json:"tags"`
+	Parameters  []Parameter          `json:"parameters,omitempty"`
+	Responses   map[string]*Response `json:"responses"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Style       string  `json:"style,omitempty"`
+	Explode     *bool   `json:"explode,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas map[string]*Schema `json:"schemas"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	OneOf                []*Schema          `json:"oneOf,omitempty"`
+}
+
+// Field of a resource struct, used to describe fields typed as resource interfaces
+type field struct {
+	Owner reflect.Type
+	Name  string
+}
+
+// Builds schemas of resources by reflection and collects them to components
+type schemaRegistry struct {
+	schemas map[string]*Schema
+	fields  map[field]*Schema
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		schemas: make(map[string]*Schema),
+		fields:  make(map[field]*Schema),
+	}
+}
+
+// Describe field of the owner struct by the schema of the given value
+func (registry *schemaRegistry) setField(owner interface{}, name string, schema *Schema) {
+	registry.fields[field{reflect.TypeOf(owner), name}] = schema
+}
+
+// Get schema of value, structs are added to components and referenced
+func (registry *schemaRegistry) Of(value interface{}) *Schema {
+	return registry.schemaOf(reflect.TypeOf(value))
+}
+
+func (registry *schemaRegistry) schemaOf(t reflect.Type) *Schema {
+	if t == nil {
+		return &Schema{}
+	}
+
+	if t == reflect.TypeOf(time.Time{}) {
+		return &Schema{Type: "string", Format: "date-time"}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		schema := registry.schemaOf(t.Elem())
+		if schema.Ref != "" {
+			return schema
+		}
+
+		schema.Nullable = true
+		return schema
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64", Minimum: new(float64)}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &Schema{Type: "array", Items: registry.schemaOf(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: registry.schemaOf(t.Elem())}
+	case reflect.Struct:
+		return registry.structRef(t)
+	}
+
+	// interfaces without known resource are described as any value
+	return &Schema{}
+}
+
+func (registry *schemaRegistry) structRef(t reflect.Type) *Schema {
+	name := schemaName(t)
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+	if _, ok := registry.schemas[name]; ok {
+		return ref
+	}
+
+	// register before properties to support recursive types
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	registry.schemas[name] = schema
+
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		jsonName := strings.Split(f.Tag.Get("json"), ",")[0]
+		if jsonName == "-" || f.PkgPath != "" {
+			continue
+		}
+
+		if jsonName == "" {
+			jsonName = f.Name
+		}
+
+		if known, ok := registry.fields[field{t, f.Name}]; ok {
+			schema.Properties[jsonName] = known
+			continue
+		}
+
+		schema.Properties[jsonName] = registry.schemaOf(f.Type)
+	}
+
+	return ref
+}
+
+// Schema name by package and type names, e.g. transaction.Resource is TransactionResource
+func schemaName(t reflect.Type) string {
+	path := strings.Split(t.PkgPath(), "/")
+	name := ""
+	for _, part := range strings.Split(path[len(path)-1], "_") {
+		name += upperFirst(part)
+	}
+
+	return name + upperFirst(t.Name())
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+
+	runes := []rune(s)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// Descriptions of custom request validators
+var validatorDescriptions = map[string]string{
+	"noahAddress":          "Noah address with NOAHx prefix",
+	"noahTxHash":           "Transaction hash with Nt prefix",
+	"noahPubKey":           "Validator public key with Np prefix",
+	"timestamp":            "Date or date time, e.g. 2019-09-30 or 2019-09-30 12:00:00",
+	"paginationCursor":     "Opaque cursor from meta.next_cursor, empty value starts from the latest rows",
+	"paginationAfter":      "Id of the last row of the previous page, switches list to cursor pagination",
+	"paginationAfterBlock": "Height of the last block of the previous page, switches list to cursor pagination",
+}
+
+// Describe parameters of request struct by uri and form tags and binding rules
+func requestParameters(request interface{}) []Parameter {
+	if request == nil {
+		return nil
+	}
+
+	t := reflect.TypeOf(request)
+	params := make([]Parameter, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			params = append(params, requestParameters(reflect.Zero(f.Type).Interface())...)
+			continue
+		}
+
+		param := Parameter{In: "query", Name: f.Tag.Get("form")}
+		if uri := f.Tag.Get("uri"); uri != "" {
+			param = Parameter{In: "path", Name: uri, Required: true}
+		}
+
+		if param.Name == "" {
+			continue
+		}
+
+		param.Schema = parameterSchema(f.Type)
+		if param.Schema.Type == "array" {
+			explode := true
+			param.Style, param.Explode = "form", &explode
+		}
+
+		applyBindingRules(&param, f.Tag.Get("binding"))
+		if param.Description == "" {
+			param.Description = parameterDescriptions[param.Name]
+		}
+
+		params = append(params, param)
+	}
+
+	return params
+}
+
+func parameterSchema(t reflect.Type) *Schema {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		return &Schema{Type: "array", Items: parameterSchema(t.Elem())}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}
+	}
+
+	return &Schema{Type: "string"}
+}
+
+// Apply validation rules of binding tag, rules after dive apply to array items
+func applyBindingRules(param *Parameter, binding string) {
+	schema := param.Schema
+	for _, rule := range strings.Split(binding, ",") {
+		name, value := rule, ""
+		if i := strings.Index(rule, "="); i != -1 {
+			name, value = rule[:i], rule[i+1:]
+		}
+
+		switch name {
+		case "dive":
+			if schema.Items != nil {
+				schema = schema.Items
+			}
+		case "required":
+			param.Required = true
+		case "numeric":
+			schema.Pattern = "^[0-9]+$"
+		case "paginationAfter", "paginationAfterBlock":
+			schema.Pattern = "^[1-9][0-9]*$"
+			param.Description = validatorDescriptions[name]
+		case "min":
+			if min, err := strconv.ParseFloat(value, 64); err == nil && schema.Type == "integer" {
+				schema.Minimum = &min
+			}
+		case "max":
+			if max, err := strconv.Atoi(value); err == nil {
+				switch schema.Type {
+				case "array":
+					schema.MaxItems = &max
+				case "integer":
+					maximum := float64(max)
+					schema.Maximum = &maximum
+				default:
+					schema.MaxLength = &max
+				}
+			}
+		case "eq":
+			for _, option := range strings.Split(rule, "|") {
+				schema.Enum = append(schema.Enum, strings.TrimPrefix(option, "eq="))
+			}
+		default:
+			if description, ok := validatorDescriptions[name]; ok {
+				param.Description = description
+			}
+		}
+	}
+}
+
+func operationId(method string, path string) string {
+	id := strings.ToLower(method)
+	for _, part := range strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '-' || r == '.'
+	}) {
+		if strings.HasPrefix(part, ":") {
+			part = "By" + upperFirst(part[1:])
+		}
+
+		id += upperFirst(part)
+	}
+
+	return id
+}
+
+// Convert gin route path to OpenAPI path template
+func openApiPath(path string) string {
+	parts := strings.Split(path, "/")
+	for i, part := range parts {
+		if strings.HasPrefix(part, ":") {
+			parts[i] = fmt.Sprintf("{%s}", part[1:])
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
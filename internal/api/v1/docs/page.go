@@ -0,0 +1,197 @@
+package docs
+
+// Self-contained page rendering openapi.json, it must not load assets from other hosts
+const docsPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Noah Explorer API</title>
+<style>
+body { margin: 0; font: 14px/1.5 -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; color: #222; display: flex; }
+nav { width: 260px; height: 100vh; overflow-y: auto; position: sticky; top: 0; background: #f5f6f8; padding: 16px; box-sizing: border-box; flex-shrink: 0; }
+nav h2 { font-size: 12px; text-transform: uppercase; color: #888; margin: 16px 0 4px; }
+nav a { display: block; color: #333; text-decoration: none; padding: 2px 0; word-break: break-all; }
+nav a:hover { color: #0a66c2; }
+main { flex: 1; padding: 16px 32px; min-width: 0; }
+section { border-bottom: 1px solid #e5e5e5; padding: 16px 0; }
+h1 { margin-top: 0; }
+.method { display: inline-block; background: #0a66c2; color: #fff; border-radius: 3px; padding: 0 6px; margin-right: 8px; font-size: 12px; }
+.path { font-family: monospace; font-size: 15px; }
+table { border-collapse: collapse; margin: 8px 0; }
+td, th { border: 1px solid #e5e5e5; padding: 4px 8px; text-align: left; vertical-align: top; }
+input { width: 220px; }
+pre { background: #f5f6f8; padding: 8px; overflow: auto; max-height: 400px; }
+.schema { font-family: monospace; white-space: pre; }
+.schema a { color: #0a66c2; }
+.muted { color: #888; }
+</style>
+</head>
+<body>
+<nav id="nav"></nav>
+<main id="main"><p class="muted">Loading specification...</p></main>
+<script>
+(function () {
+  var spec;
+
+  function el(tag, attrs, children) {
+    var node = document.createElement(tag);
+    Object.keys(attrs || {}).forEach(function (key) {
+      if (key === 'text') node.textContent = attrs[key];
+      else node.setAttribute(key, attrs[key]);
+    });
+    (children || []).forEach(function (child) { if (child) node.appendChild(child); });
+    return node;
+  }
+
+  function refName(ref) { return ref.replace('#/components/schemas/', ''); }
+
+  // Render schema as a compact type tree, referenced schemas link to components
+  function renderSchema(schema, indent, out) {
+    indent = indent || '';
+    if (!schema) return;
+    if (schema.$ref) {
+      out.appendChild(el('a', {href: '#schema-' + refName(schema.$ref), text: refName(schema.$ref)}));
+    } else if (schema.oneOf) {
+      out.appendChild(document.createTextNode('oneOf('));
+      schema.oneOf.forEach(function (item, i) {
+        if (i) out.appendChild(document.createTextNode(' | '));
+        renderSchema(item, indent, out);
+      });
+      out.appendChild(document.createTextNode(')'));
+    } else if (schema.type === 'array') {
+      out.appendChild(document.createTextNode('['));
+      renderSchema(schema.items, indent, out);
+      out.appendChild(document.createTextNode(']'));
+    } else if (schema.properties) {
+      out.appendChild(document.createTextNode('{\n'));
+      Object.keys(schema.properties).forEach(function (name) {
+        out.appendChild(document.createTextNode(indent + '  ' + name + ': '));
+        renderSchema(schema.properties[name], indent + '  ', out);
+        out.appendChild(document.createTextNode('\n'));
+      });
+      out.appendChild(document.createTextNode(indent + '}'));
+    } else {
+      out.appendChild(document.createTextNode((schema.type || 'any') + (schema.nullable ? ' | null' : '')));
+    }
+    if (schema.description && !schema.properties) {
+      out.appendChild(el('span', {'class': 'muted', text: '  // ' + schema.description}));
+    }
+  }
+
+  function schemaBlock(schema) {
+    var block = el('div', {'class': 'schema'});
+    renderSchema(schema, '', block);
+    return block;
+  }
+
+  function paramSchemaText(schema) {
+    var text = schema.type === 'array' ? 'array of ' + schema.items.type : schema.type;
+    if (schema.enum) text += ': ' + schema.enum.join(', ');
+    return text;
+  }
+
+  function renderOperation(path, op) {
+    var id = op.operationId;
+    var section = el('section', {id: id}, [
+      el('div', {}, [el('span', {'class': 'method', text: 'GET'}), el('span', {'class': 'path', text: path})]),
+      el('p', {text: op.summary})
+    ]);
+
+    var inputs = {};
+    var rows = (op.parameters || []).map(function (param) {
+      var input = el('input', {placeholder: param.schema.type === 'array' ? 'comma separated' : ''});
+      inputs[param.name] = {param: param, input: input};
+      return el('tr', {}, [
+        el('td', {text: param.name + (param.required ? ' *' : '')}),
+        el('td', {text: param.in}),
+        el('td', {text: paramSchemaText(param.schema)}),
+        el('td', {text: param.description || ''}),
+        el('td', {}, [input])
+      ]);
+    });
+    if (rows.length) {
+      section.appendChild(el('table', {}, [el('tr', {}, ['Parameter', 'In', 'Type', 'Description', 'Value'].map(function (name) {
+        return el('th', {text: name});
+      }))].concat(rows)));
+    }
+
+    Object.keys(op.responses).forEach(function (status) {
+      var content = op.responses[status].content;
+      section.appendChild(el('p', {text: status + ' - ' + op.responses[status].description}));
+      if (content && content['application/json']) section.appendChild(schemaBlock(content['application/json'].schema));
+    });
+
+    var output = el('pre', {'class': 'muted', text: 'Fill parameters and send the request'});
+    var button = el('button', {text: 'Send request'});
+    button.onclick = function () {
+      var url = spec.servers[0].url + path;
+      var query = [];
+      Object.keys(inputs).forEach(function (name) {
+        var value = inputs[name].input.value.trim();
+        if (!value) return;
+        if (inputs[name].param.in === 'path') {
+          url = url.replace('{' + name + '}', encodeURIComponent(value));
+        } else {
+          value.split(inputs[name].param.schema.type === 'array' ? ',' : '\n').forEach(function (item) {
+            query.push(encodeURIComponent(name) + '=' + encodeURIComponent(item.trim()));
+          });
+        }
+      });
+      if (query.length) url += '?' + query.join('&');
+      if (op.responses['200'].content && op.responses['200'].content['text/event-stream']) {
+        output.textContent = 'Open ' + url + ' with EventSource to receive events';
+        return;
+      }
+      output.textContent = 'GET ' + url + '\n...';
+      fetch(url).then(function (response) {
+        return response.text().then(function (body) {
+          try { body = JSON.stringify(JSON.parse(body), null, 2); } catch (e) {}
+          output.textContent = 'GET ' + url + '\n' + response.status + '\n' + body;
+        });
+      }).catch(function (error) { output.textContent = String(error); });
+    };
+    section.appendChild(button);
+    section.appendChild(output);
+    return section;
+  }
+
+  function render() {
+    var nav = document.getElementById('nav');
+    var main = document.getElementById('main');
+    main.innerHTML = '';
+    main.appendChild(el('h1', {text: spec.info.title + ' ' + spec.info.version}));
+    main.appendChild(el('p', {text: spec.info.description}));
+    main.appendChild(el('p', {}, [el('a', {href: 'openapi.json', text: 'openapi.json'})]));
+
+    spec.tags.forEach(function (tag) {
+      nav.appendChild(el('h2', {text: tag.name}));
+      main.appendChild(el('h2', {text: tag.name}));
+      Object.keys(spec.paths).sort().forEach(function (path) {
+        var op = spec.paths[path].get;
+        if (op.tags[0] !== tag.name) return;
+        nav.appendChild(el('a', {href: '#' + op.operationId, text: path}));
+        main.appendChild(renderOperation(path, op));
+      });
+    });
+
+    main.appendChild(el('h2', {text: 'Schemas'}));
+    Object.keys(spec.components.schemas).sort().forEach(function (name) {
+      main.appendChild(el('section', {id: 'schema-' + name}, [
+        el('h3', {text: name}),
+        schemaBlock(spec.components.schemas[name])
+      ]));
+    });
+  }
+
+  fetch('openapi.json').then(function (response) { return response.json(); }).then(function (data) {
+    spec = data;
+    render();
+  }).catch(function (error) {
+    document.getElementById('main').textContent = 'Failed to load specification: ' + error;
+  });
+})();
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,9 @@
+package docs
+
+import "github.com/gin-gonic/gin"
+
+// ApplyRoutes applies router to the gin Engine
+func ApplyRoutes(r *gin.RouterGroup) {
+	r.GET("/openapi.json", GetOpenApi)
+	r.GET("/docs", GetDocs)
+}
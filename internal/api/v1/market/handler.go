@@ -0,0 +1,93 @@
+package market
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/market"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+)
+
+type GetPriceRequest struct {
+	Time *string `form:"time" binding:"omitempty,timestamp"`
+}
+
+type GetPricesRequest struct {
+	Scale     *string `form:"scale"     binding:"omitempty,eq=hour|eq=day"`
+	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
+	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
+}
+
+// Get the current market price of the base coin or the price at the time
+func GetPrice(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	var request GetPriceRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	price := explorer.Market.Price()
+	if request.Time != nil {
+		t, _ := helpers.ParseTimestamp(*request.Time)
+
+		var err error
+		if price, err = explorer.Market.PriceAt(t); err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	if price == nil {
+		c.Error(errors.NewNotFound("Market price not found."))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": new(market.Resource).Transform(*price),
+	})
+}
+
+// Get history of market prices of the base coin
+func GetPrices(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	var request GetPricesRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// set defaults
+	scale := config.DefaultStatisticsScale
+	if request.Scale != nil {
+		scale = *request.Scale
+	}
+
+	startTime := helpers.StartOfTheDay(time.Now().AddDate(0, 0, config.DefaultStatisticsDayDelta))
+	if request.StartTime != nil {
+		startTime, _ = helpers.ParseTimestamp(*request.StartTime)
+	}
+
+	var endTime *time.Time
+	if request.EndTime != nil {
+		t, _ := helpers.ParseTimestamp(*request.EndTime)
+		endTime = &t
+	}
+
+	prices, err := explorer.Market.History(scale, startTime, endTime)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resource.TransformCollection(prices, market.Resource{}),
+	})
+}
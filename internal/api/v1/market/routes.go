@@ -0,0 +1,12 @@
+package market
+
+import "github.com/gin-gonic/gin"
+
+// ApplyRoutes applies router to the gin Engine
+func ApplyRoutes(r *gin.RouterGroup) {
+	market := r.Group("/market")
+	{
+		market.GET("/price", GetPrice)
+		market.GET("/prices", GetPrices)
+	}
+}
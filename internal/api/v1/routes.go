@@ -5,8 +5,12 @@ import (
 	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/addresses"
 	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/blocks"
 	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/coins"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/docs"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/market"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/search"
 	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/statistics"
 	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/status"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/stream"
 	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/transactions"
 	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/validators"
 )
@@ -21,6 +25,10 @@ func ApplyRoutes(r *gin.RouterGroup) {
 		transactions.ApplyRoutes(v1)
 		validators.ApplyRoutes(v1)
 		statistics.ApplyRoutes(v1)
+		market.ApplyRoutes(v1)
 		status.ApplyRoutes(v1)
+		stream.ApplyRoutes(v1)
+		search.ApplyRoutes(v1)
+		docs.ApplyRoutes(v1)
 	}
 }
@@ -0,0 +1,31 @@
+package apiV1
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/v1/docs"
+)
+
+func TestRoutesAreDocumented(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	ApplyRoutes(router.Group("/api"))
+
+	documented := make(map[string]bool)
+	for _, endpoint := range docs.Endpoints {
+		documented["/api/v1"+endpoint.Path] = true
+	}
+
+	for _, route := range router.Routes() {
+		if !documented[route.Path] {
+			t.Errorf("Route %s %s is not described in docs.Endpoints", route.Method, route.Path)
+		}
+
+		delete(documented, route.Path)
+	}
+
+	for path := range documented {
+		t.Errorf("Documented endpoint %s is not routed", path)
+	}
+}
@@ -0,0 +1,134 @@
+package search
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/noah-blockchain/coinExplorer-tools/helpers"
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/api/validators"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+	"github.com/noah-blockchain/noah-explorer-api/internal/search"
+)
+
+type SearchRequest struct {
+	Query string `form:"q" binding:"required,max=100"`
+}
+
+// Search address, transaction, block, validator or coin by any identifier
+func Search(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request SearchRequest
+	err := c.ShouldBindQuery(&request)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	matches, err := findMatches(explorer, strings.TrimSpace(request.Query))
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": resource.TransformCollection(matches, search.Resource{})})
+}
+
+// Find models matching the query, exact matches go before suggestions by prefix
+func findMatches(explorer *core.Explorer, query string) ([]interface{}, error) {
+	matches := make([]interface{}, 0)
+
+	switch {
+	case query == "":
+		return matches, nil
+	case validators.IsNoahAddress(query):
+		// address without transactions is known to the address endpoint as well
+		address := models.Address{Address: strings.ToLower(helpers.RemovePrefixFromAddress(query))}
+		model, err := explorer.AddressRepository.GetByAddress(address.Address)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		if model != nil {
+			address = *model
+		}
+
+		return append(matches, address), nil
+	case validators.IsNoahTxHash(query):
+		return findTransaction(explorer, strings.ToLower(helpers.RemovePrefix(query)))
+	case validators.IsNoahPublicKey(query):
+		model, err := explorer.ValidatorRepository.GetByPublicKey(strings.ToLower(helpers.RemovePrefix(query)))
+		if errors.IsNotFound(err) {
+			return matches, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		return append(matches, *model), nil
+	}
+
+	if height, err := strconv.ParseUint(query, 10, 64); err == nil {
+		block, err := explorer.BlockRepository.GetById(height)
+		if err != nil && !errors.IsNotFound(err) {
+			return nil, err
+		}
+
+		if block != nil {
+			matches = append(matches, *block)
+		}
+	}
+
+	coins, err := explorer.CoinRepository.GetBySymbolPrefix(query, config.SearchSuggestionsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, coin := range coins {
+		matches = append(matches, coin)
+	}
+
+	validatorsList, err := explorer.ValidatorRepository.GetByNamePrefix(query, config.SearchSuggestionsLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, validator := range validatorsList {
+		matches = append(matches, validator)
+	}
+
+	return matches, nil
+}
+
+// Find transaction by hash among valid and invalid transactions
+func findTransaction(explorer *core.Explorer, hash string) ([]interface{}, error) {
+	matches := make([]interface{}, 0)
+
+	tx, err := explorer.TransactionRepository.GetTxByHash(hash)
+	if err == nil {
+		return append(matches, *tx), nil
+	}
+
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+
+	invalidTx, err := explorer.InvalidTransactionRepository.GetTxByHash(hash)
+	if errors.IsNotFound(err) {
+		return matches, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return append(matches, *invalidTx), nil
+}
@@ -0,0 +1,8 @@
+package search
+
+import "github.com/gin-gonic/gin"
+
+// ApplyRoutes applies router to the gin Engine
+func ApplyRoutes(r *gin.RouterGroup) {
+	r.GET("/search", Search)
+}
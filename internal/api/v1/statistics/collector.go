@@ -0,0 +1,54 @@
+package statistics
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/decentralization"
+)
+
+// Save daily snapshots of decentralization metrics on schedule, days when the api is down have no snapshots
+func CollectDecentralizationSnapshots(ctx context.Context, explorer *core.Explorer) {
+	ticker := time.NewTicker(config.DecentralizationSnapshotPeriodInSec * time.Second)
+	defer ticker.Stop()
+
+	for {
+		report, err := getDecentralizationReport(explorer)
+		if err == nil {
+			err = explorer.DecentralizationRepository.SaveSnapshot(report.Snapshot(time.Now().UTC()))
+		}
+
+		if err != nil {
+			log.Printf("statistics: failed to save decentralization snapshot: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Compute metrics of active validators by the last block
+func getDecentralizationReport(explorer *core.Explorer) (*decentralization.Report, error) {
+	ids, err := explorer.ValidatorRepository.GetActiveValidatorIds()
+	if err != nil {
+		return nil, err
+	}
+
+	validators, err := explorer.DecentralizationRepository.GetValidators(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	stakes, err := explorer.DecentralizationRepository.GetDelegatorStakes(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	return decentralization.NewReport(validators, stakes, config.DecentralizationTopCount)
+}
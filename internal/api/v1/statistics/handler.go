@@ -8,6 +8,7 @@ import (
 	"github.com/noah-blockchain/noah-explorer-api/internal/chart"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/decentralization"
 	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
 	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
@@ -19,6 +20,11 @@ type GetTransactionsRequest struct {
 	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
 }
 
+type GetDecentralizationHistoryRequest struct {
+	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
+	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
+}
+
 // statistics cache time
 const CacheTime = time.Duration(600)
 
@@ -43,7 +49,7 @@ func GetTransactions(c *gin.Context) {
 		startTime = *request.StartTime
 	}
 
-	txFunc := func() interface{} {
+	txFunc := func() (interface{}, error) {
 		return explorer.TransactionRepository.GetTxCountChartDataByFilter(chart.SelectFilter{
 			Scale:     scale,
 			StartTime: &startTime,
@@ -53,13 +59,57 @@ func GetTransactions(c *gin.Context) {
 
 	// cache request without query parameters
 	var txs interface{}
+	var err error
 	if len(c.Request.URL.Query()) == 0 {
-		txs = explorer.Cache.Get("tx_statistics", txFunc, CacheTime)
+		txs, err = explorer.Cache.Get("tx_statistics", txFunc, CacheTime)
 	} else {
-		txs = txFunc()
+		txs, err = txFunc()
+	}
+
+	if err != nil {
+		c.Error(err)
+		return
 	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"data": resource.TransformCollection(txs, chart.TransactionResource{}),
 	})
 }
+
+// Get stake distribution metrics of active validators and of delegators of each validator
+func GetDecentralization(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	report, err := explorer.Cache.Get("decentralization", func() (interface{}, error) {
+		return getDecentralizationReport(explorer)
+	}, CacheTime)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": new(decentralization.Resource).Transform(*report.(*decentralization.Report)),
+	})
+}
+
+// Get daily history of stake distribution metrics of active validators
+func GetDecentralizationHistory(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	var request GetDecentralizationHistoryRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	snapshots, err := explorer.DecentralizationRepository.GetSnapshots(request.StartTime, request.EndTime)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resource.TransformCollection(snapshots, decentralization.SnapshotResource{}),
+	})
+}
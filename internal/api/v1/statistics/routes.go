@@ -7,5 +7,7 @@ func ApplyRoutes(r *gin.RouterGroup) {
 	statistics := r.Group("/statistics")
 	{
 		statistics.GET("/transactions", GetTransactions)
+		statistics.GET("/decentralization", GetDecentralization)
+		statistics.GET("/decentralization/history", GetDecentralizationHistory)
 	}
 }
@@ -0,0 +1,113 @@
+package status
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/metrics"
+	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
+)
+
+var (
+	latestBlockHeight = metrics.NewGaugeVec("explorer_chain_latest_block_height", "Height of the latest block.")
+	averageBlockTime  = metrics.NewGaugeVec("explorer_chain_average_block_time_seconds", "Average block time by the last 24 hours.")
+	chainUptime       = metrics.NewGaugeVec("explorer_chain_uptime_percent", "Network uptime by the last 24 hours.")
+	activeValidators  = metrics.NewGaugeVec("explorer_chain_active_validators", "Count of validators in the last block.")
+	activeCandidates  = metrics.NewGaugeVec("explorer_chain_active_candidates", "Count of candidates ready to validate.")
+	txPerSecond       = metrics.NewGaugeVec("explorer_chain_transactions_per_second", "Transactions per second by the last 24 hours.")
+	delegatedNoah     = metrics.NewGaugeVec("explorer_chain_delegated_noah", "Total delegated stake in NOAH.")
+
+	// unix time of the latest collected block, the age is computed on scrape
+	latestBlockTime int64
+	_               = metrics.NewGaugeFunc("explorer_chain_latest_block_age_seconds", "Seconds since the latest block.", func() float64 {
+		blockTime := atomic.LoadInt64(&latestBlockTime)
+		if blockTime == 0 {
+			return 0
+		}
+
+		return time.Since(time.Unix(blockTime, 0)).Seconds()
+	})
+)
+
+// Collect chain health metrics on schedule, so that scrapes never query the database
+func CollectChainMetrics(ctx context.Context, explorer *core.Explorer) {
+	ticker := time.NewTicker(config.ChainMetricsCollectPeriodInSec * time.Second)
+	defer ticker.Stop()
+
+	for {
+		collectChainMetrics(explorer)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func collectChainMetrics(explorer *core.Explorer) {
+	lastBlockCh := make(chan Data)
+	avgTimeCh := make(chan Data)
+	slowBlocksTimeSumCh := make(chan Data)
+	activeValidatorsCh := make(chan Data)
+	activeCandidatesCh := make(chan Data)
+	tx24hDataCh := make(chan Data)
+	stakesSumCh := make(chan Data)
+
+	go getLastBlock(explorer, lastBlockCh)
+	go getAverageBlockTime(explorer, avgTimeCh)
+	go getSumSlowBlocksTime(explorer, slowBlocksTimeSumCh)
+	go getActiveValidatorsCount(explorer, activeValidatorsCh)
+	go getActiveCandidatesCount(explorer, activeCandidatesCh)
+	go getTransactionsDataBy24h(explorer, tx24hDataCh)
+	go getStakesSum(explorer, stakesSumCh)
+
+	// the gauges keep previous values when a value could not be fetched
+	if data := receiveMetricData(lastBlockCh); data != nil {
+		lastBlock := data.(models.Block)
+		latestBlockHeight.Set(float64(lastBlock.ID))
+		atomic.StoreInt64(&latestBlockTime, lastBlock.CreatedAt.Unix())
+	}
+
+	if data := receiveMetricData(avgTimeCh); data != nil {
+		averageBlockTime.Set(data.(float64))
+	}
+
+	if data := receiveMetricData(slowBlocksTimeSumCh); data != nil {
+		chainUptime.Set(calculateUptime(data.(float64)))
+	}
+
+	if data := receiveMetricData(activeValidatorsCh); data != nil {
+		activeValidators.Set(float64(data.(int)))
+	}
+
+	if data := receiveMetricData(activeCandidatesCh); data != nil {
+		activeCandidates.Set(float64(data.(int)))
+	}
+
+	if data := receiveMetricData(tx24hDataCh); data != nil {
+		txPerSecond.Set(getTransactionSpeed(data.(transaction.Tx24hData).Count))
+	}
+
+	if data := receiveMetricData(stakesSumCh); data != nil {
+		if sum, err := strconv.ParseFloat(data.(string), 64); err == nil {
+			delegatedNoah.Set(sum)
+		}
+	}
+}
+
+func receiveMetricData(ch chan Data) interface{} {
+	data := <-ch
+	if data.Error != nil {
+		log.Printf("status: failed to collect chain metrics: %s", data.Error)
+		return nil
+	}
+
+	return data.Result
+}
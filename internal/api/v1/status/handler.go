@@ -1,11 +1,11 @@
 package status
 
 import (
-	"errors"
 	"fmt"
 	"github.com/noah-blockchain/noah-explorer-api/internal/coins"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
 	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
 	"math"
@@ -45,10 +45,10 @@ func GetStatus(c *gin.Context) {
 	lastBlockData, avgBlockTime := <-lastBlockCh, <-avgTimeCh
 
 	// handle errors from goroutines
-	helpers.CheckErr(txTotalCount.Error)
-	helpers.CheckErr(txTotalCount24h.Error)
-	helpers.CheckErr(lastBlockData.Error)
-	helpers.CheckErr(avgBlockTime.Error)
+	if err := firstError(txTotalCount, txTotalCount24h, lastBlockData, avgBlockTime); err != nil {
+		c.Error(err)
+		return
+	}
 
 	// prepare data
 	lastBlock := lastBlockData.Result.(models.Block)
@@ -95,15 +95,12 @@ func GetStatusPage(c *gin.Context) {
 	stakesSumData, customCoinsData := <-stakesSumCh, <-customCoinsDataCh
 
 	// handle errors from goroutines
-	helpers.CheckErr(tx24hData.Error)
-	helpers.CheckErr(txTotalCount.Error)
-	helpers.CheckErr(activeValidators.Error)
-	helpers.CheckErr(activeCandidates.Error)
-	helpers.CheckErr(avgBlockTime.Error)
-	helpers.CheckErr(lastBlockData.Error)
-	helpers.CheckErr(slowBlocksTimeSum.Error)
-	helpers.CheckErr(stakesSumData.Error)
-	helpers.CheckErr(customCoinsData.Error)
+	err := firstError(tx24hData, txTotalCount, activeValidators, activeCandidates,
+		avgBlockTime, lastBlockData, slowBlocksTimeSum, stakesSumData, customCoinsData)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	// prepare data
 	lastBlock := lastBlockData.Result.(models.Block)
@@ -111,11 +108,24 @@ func GetStatusPage(c *gin.Context) {
 	customCoins := customCoinsData.Result.(coins.CustomCoinsStatusData)
 	stakesSum := stakesSumData.Result.(string)
 
+	freeFloatNoah, err := getFreeNoahSum(stakesSum, lastBlock.ID)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
 	status := "down"
 	if isActive(lastBlock) {
 		status = "active"
 	}
 
+	// market values are null until the market price is received
+	var fiatPrice, marketCap *float64
+	if price := explorer.Market.Price(); price != nil {
+		capitalization := getMarketCap(helpers.CalculateEmission(lastBlock.ID), price.Value)
+		fiatPrice, marketCap = &price.Value, &capitalization
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"data": gin.H{
 			"status":              status,
@@ -131,125 +141,110 @@ func GetStatusPage(c *gin.Context) {
 			"totalCommission":     helpers.Unit2Noah(tx24h.FeeSum),
 			"customCoinsSum":      helpers.QNoahStr2Noah(customCoins.ReserveSum),
 			"noahEmission":        helpers.CalculateEmission(lastBlock.ID),
-			"freeFloatNoah":       getFreeNoahSum(stakesSum, lastBlock.ID),
+			"freeFloatNoah":       freeFloatNoah,
 			"txPerSecond":         getTransactionSpeed(tx24h.Count),
 			"uptime":              calculateUptime(slowBlocksTimeSum.Result.(float64)),
+			"fiatCurrency":        explorer.Market.Currency(),
+			"fiatPrice":           fiatPrice,
+			"marketCap":           marketCap,
 		},
 	})
 }
 
 func getTotalTxCount(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
-	data := explorer.Cache.Get(fmt.Sprintf("total_tx_count"), func() interface{} {
+	data, err := explorer.Cache.Get(fmt.Sprintf("total_tx_count"), func() (interface{}, error) {
 		return explorer.TransactionRepository.GetTotalTransactionCount(nil)
 	}, PageCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
 func getLastBlock(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
-	data := explorer.Cache.Get("last_block", func() interface{} {
+	data, err := explorer.Cache.Get("last_block", func() (interface{}, error) {
 		return explorer.BlockRepository.GetLastBlock()
 	}, PageCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
 func getActiveCandidatesCount(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
-	data := explorer.Cache.Get("active_candidates_count", func() interface{} {
+	data, err := explorer.Cache.Get("active_candidates_count", func() (interface{}, error) {
 		return explorer.ValidatorRepository.GetActiveCandidatesCount()
 	}, PageCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
 func getActiveValidatorsCount(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
-	data := explorer.Cache.Get("active_validators_count", func() interface{} {
-		return len(explorer.ValidatorRepository.GetActiveValidatorIds())
+	data, err := explorer.Cache.Get("active_validators_count", func() (interface{}, error) {
+		ids, err := explorer.ValidatorRepository.GetActiveValidatorIds()
+		return len(ids), err
 	}, PageCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
 func getAverageBlockTime(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
-	data := explorer.Cache.Get("avg_block_time", func() interface{} {
+	data, err := explorer.Cache.Get("avg_block_time", func() (interface{}, error) {
 		return explorer.BlockRepository.GetAverageBlockTime()
 	}, SlowAvgBlocksCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
 func getSumSlowBlocksTime(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
-	data := explorer.Cache.Get("slow_blocks_count", func() interface{} {
+	data, err := explorer.Cache.Get("slow_blocks_count", func() (interface{}, error) {
 		return explorer.BlockRepository.GetSumSlowBlocksTimeBy24h()
 	}, SlowAvgBlocksCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
 func getTransactionsDataBy24h(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
-	data := explorer.Cache.Get("tx_24h_data", func() interface{} {
+	data, err := explorer.Cache.Get("tx_24h_data", func() (interface{}, error) {
 		return explorer.TransactionRepository.Get24hTransactionsData()
-	}, LastDataCacheTime).(transaction.Tx24hData)
+	}, LastDataCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
 func getTotalTxCountByLastDay(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
 	startTime := time.Now().AddDate(0, 0, -1).Format("2006-01-02 15:04:05")
-	data := explorer.Cache.Get("last_day_total_tx_count", func() interface{} {
+	data, err := explorer.Cache.Get("last_day_total_tx_count", func() (interface{}, error) {
 		return explorer.TransactionRepository.GetTotalTransactionCount(&startTime)
 	}, LastDataCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
 func getStakesSum(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
-	data := explorer.Cache.Get(fmt.Sprintf("stakes_sum"), func() interface{} {
+	data, err := explorer.Cache.Get(fmt.Sprintf("stakes_sum"), func() (interface{}, error) {
 		sum, err := explorer.StakeRepository.GetSumInNoahValue()
-		helpers.CheckErr(err)
+		if err != nil {
+			return nil, err
+		}
 
-		return helpers.QNoahStr2Noah(sum)
+		return helpers.QNoahStr2Noah(sum), nil
 	}, PageCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
 func getCustomCoinsData(explorer *core.Explorer, ch chan Data) {
-	defer recoveryStatusData(ch)
-
-	data := explorer.Cache.Get(fmt.Sprintf("custom_coins_data"), func() interface{} {
-		data, err := explorer.CoinRepository.GetCustomCoinsStatusData()
-		helpers.CheckErr(err)
-
-		return data
+	data, err := explorer.Cache.Get(fmt.Sprintf("custom_coins_data"), func() (interface{}, error) {
+		return explorer.CoinRepository.GetCustomCoinsStatusData()
 	}, PageCacheTime)
 
-	ch <- Data{data, nil}
+	ch <- Data{data, err}
 }
 
-func getFreeNoahSum(stakesSum string, lastBlockId uint64) float64 {
+func getFreeNoahSum(stakesSum string, lastBlockId uint64) (float64, error) {
 	stakes, err := strconv.ParseFloat(stakesSum, 64)
-	helpers.CheckErr(err)
+	if err != nil {
+		return 0, errors.NewMalformedData("Invalid sum of stakes", err)
+	}
 
-	return float64(helpers.CalculateEmission(lastBlockId)) - stakes
+	return float64(helpers.CalculateEmission(lastBlockId)) - stakes, nil
 }
 
 func getTransactionSpeed(total int) float64 {
@@ -268,12 +263,13 @@ func getMarketCap(noahCount uint64, fiatPrice float64) float64 {
 	return float64(noahCount) * fiatPrice
 }
 
-func recoveryStatusData(ch chan Data) {
-	if err := recover(); err != nil {
-		if e, ok := err.(error); ok {
-			ch <- Data{nil, e}
-		} else {
-			ch <- Data{nil, errors.New(err.(string))}
+// Get the first error of status data fetched by goroutines
+func firstError(data ...Data) error {
+	for _, d := range data {
+		if d.Error != nil {
+			return d.Error
 		}
 	}
+
+	return nil
 }
@@ -0,0 +1,63 @@
+package stream
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/stream"
+)
+
+type GetStreamRequest struct {
+	Addresses  []string `form:"addresses[]"  binding:"omitempty,noahAddress"`
+	Coins      []string `form:"coins[]"      binding:"omitempty,dive,max=20"`
+	Validators []string `form:"validators[]" binding:"omitempty,dive,noahPubKey"`
+}
+
+// Stream new blocks and transactions as server-sent events
+func GetStream(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetStreamRequest
+	err := c.ShouldBindQuery(&request)
+	if err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	subscription := explorer.Feed.Subscribe(stream.NewFilter(request.Addresses, request.Coins, request.Validators))
+	if subscription == nil {
+		errors.SetErrorResponse(http.StatusServiceUnavailable, http.StatusServiceUnavailable, "Stream is closed.", c)
+		return
+	}
+
+	defer explorer.Feed.Unsubscribe(subscription)
+
+	heartbeat := time.NewTicker(config.StreamHeartbeatPeriodInSec * time.Second)
+	defer heartbeat.Stop()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, ok := <-subscription.Events:
+			if !ok {
+				return false
+			}
+
+			c.SSEvent(event.Name, event.Data)
+			return true
+		case <-heartbeat.C:
+			c.SSEvent("ping", time.Now().Format(time.RFC3339))
+			return true
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
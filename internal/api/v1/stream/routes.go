@@ -0,0 +1,8 @@
+package stream
+
+import "github.com/gin-gonic/gin"
+
+// ApplyRoutes applies router to the gin Engine
+func ApplyRoutes(r *gin.RouterGroup) {
+	r.GET("/stream", GetStream)
+}
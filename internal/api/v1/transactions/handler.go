@@ -22,6 +22,10 @@ type GetTransactionsRequest struct {
 	Page       string   `form:"page"        binding:"omitempty,numeric"`
 	StartBlock *string  `form:"startblock"  binding:"omitempty,numeric"`
 	EndBlock   *string  `form:"endblock"    binding:"omitempty,numeric"`
+	Cursor     *string  `form:"cursor"      binding:"omitempty,paginationCursor"`
+	After      *string  `form:"after"       binding:"omitempty,paginationAfter"`
+	Direction  *string  `form:"direction"   binding:"omitempty,eq=in|eq=out"`
+	transaction.FilterRequest
 }
 
 type GetTransactionRequest struct {
@@ -54,37 +58,59 @@ func GetTransactions(c *gin.Context) {
 		noahAddresses[key] = helpers.RemovePrefix(addr)
 	}
 
+	// direction is relative to the requested addresses
+	if request.Direction != nil && len(noahAddresses) == 0 {
+		c.Error(errors.NewInvalidInput("Direction filter requires addresses.", nil))
+		return
+	}
+
 	// fetch data
-	pagination := tools.NewPagination(c.Request)
+	pagination := tools.NewCursorPagination(c.Request)
+	attributes := request.FilterRequest.Filter().WithDirection(request.Direction, noahAddresses)
 
 	var txs []models.Transaction
 	if len(noahAddresses) > 0 {
-		txs = explorer.TransactionRepository.GetPaginatedTxsByAddresses(noahAddresses, transaction.BlocksRangeSelectFilter{
-			StartBlock: request.StartBlock,
-			EndBlock:   request.EndBlock,
+		txs, err = explorer.TransactionRepository.GetPaginatedTxsByAddresses(noahAddresses, tools.Filters{
+			transaction.BlocksRangeSelectFilter{
+				StartBlock: request.StartBlock,
+				EndBlock:   request.EndBlock,
+			},
+			attributes,
 		}, &pagination)
 	} else {
 		// prepare retrieving models
-		getTxsFunc := func() []models.Transaction {
-			return explorer.TransactionRepository.GetPaginatedTxsByFilter(blocks.RangeSelectFilter{
-				StartBlock: request.StartBlock,
-				EndBlock:   request.EndBlock,
+		getTxsFunc := func() ([]models.Transaction, error) {
+			return explorer.TransactionRepository.GetPaginatedTxsByFilter(tools.Filters{
+				blocks.RangeSelectFilter{
+					StartBlock: request.StartBlock,
+					EndBlock:   request.EndBlock,
+				},
+				attributes,
 			}, &pagination)
 		}
 
 		// cache last transactions
 		if len(c.Request.URL.Query()) == 0 {
-			cached := explorer.Cache.Get("transactions", func() interface{} {
-				return CacheTxData{getTxsFunc(), pagination}
-			}, CacheBlocksCount).(CacheTxData)
-
-			txs = cached.Transactions
-			pagination = cached.Pagination
+			var cached interface{}
+			cached, err = explorer.Cache.Get("transactions", func() (interface{}, error) {
+				txs, err := getTxsFunc()
+				return CacheTxData{txs, pagination}, err
+			}, CacheBlocksCount)
+
+			if err == nil {
+				txs = cached.(CacheTxData).Transactions
+				pagination = cached.(CacheTxData).Pagination
+			}
 		} else {
-			txs = getTxsFunc()
+			txs, err = getTxsFunc()
 		}
 	}
 
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
 	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(txs, transaction.Resource{}, pagination))
 }
 
@@ -102,11 +128,11 @@ func GetTransaction(c *gin.Context) {
 
 	// fetch data
 	hash := helpers.RemovePrefix(request.Hash)
-	tx := explorer.TransactionRepository.GetTxByHash(hash)
-	if tx == nil {
-		invalidTx := explorer.InvalidTransactionRepository.GetTxByHash(hash)
-		if invalidTx == nil {
-			errors.SetErrorResponse(http.StatusNotFound, http.StatusNotFound, "Transaction not found.", c)
+	tx, err := explorer.TransactionRepository.GetTxByHash(hash)
+	if errors.IsNotFound(err) {
+		invalidTx, err := explorer.InvalidTransactionRepository.GetTxByHash(hash)
+		if err != nil {
+			c.Error(errors.WithNotFoundMessage(err, "Transaction not found."))
 			return
 		}
 
@@ -116,6 +142,11 @@ func GetTransaction(c *gin.Context) {
 		return
 	}
 
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
 	c.JSON(http.StatusOK, gin.H{
 		"data": new(transaction.Resource).Transform(*tx),
 	})
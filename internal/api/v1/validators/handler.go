@@ -1,22 +1,37 @@
 package validators
 
 import (
+	"fmt"
+	"math/big"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/AlekSi/pointer"
 	"github.com/gin-gonic/gin"
 	"github.com/noah-blockchain/coinExplorer-tools/helpers"
 	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/aggregated_reward"
+	"github.com/noah-blockchain/noah-explorer-api/internal/block_validator"
+	"github.com/noah-blockchain/noah-explorer-api/internal/blocks"
+	"github.com/noah-blockchain/noah-explorer-api/internal/chart"
+	"github.com/noah-blockchain/noah-explorer-api/internal/coins"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
 	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/events"
 	apiHelper "github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+	"github.com/noah-blockchain/noah-explorer-api/internal/reward"
+	"github.com/noah-blockchain/noah-explorer-api/internal/slash"
 	"github.com/noah-blockchain/noah-explorer-api/internal/stake"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
 	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
 	"github.com/noah-blockchain/noah-explorer-api/internal/validator"
 	"github.com/noah-blockchain/noah-explorer-api/internal/validator/meta"
+	"github.com/noah-blockchain/noah-explorer-api/internal/validator/timeline"
+	nodeValidators "github.com/noah-blockchain/noah-go-node/core/validators"
 	"gopkg.in/guregu/null.v3/zero"
 )
 
@@ -26,6 +41,9 @@ type GetAggregatedValidatorRequest struct {
 	OrderBy *string `form:"order_by" binding:"omitempty"`
 }
 
+// Validator fields allowed in the filter parameter
+var SortFields = []string{"uptime", "total_stake", "commission", "count_delegators"}
+
 type GetValidatorRequest struct {
 	PublicKey string `uri:"publicKey"    binding:"required,noahPubKey"`
 }
@@ -35,6 +53,48 @@ type GetValidatorTransactionsRequest struct {
 	Page       string  `form:"page"        binding:"omitempty,numeric"`
 	StartBlock *string `form:"startblock"  binding:"omitempty,numeric"`
 	EndBlock   *string `form:"endblock"    binding:"omitempty,numeric"`
+	Cursor     *string `form:"cursor"      binding:"omitempty,paginationCursor"`
+	After      *string `form:"after"       binding:"omitempty,paginationAfter"`
+	transaction.FilterRequest
+}
+
+type CalculatorRequest struct {
+	Coin   *string `form:"coin"   binding:"omitempty,max=10"`
+	Amount string  `form:"amount" binding:"required,numeric"`
+}
+
+type FilterQueryRequest struct {
+	StartBlock *string `form:"startblock" binding:"omitempty,numeric"`
+	EndBlock   *string `form:"endblock"   binding:"omitempty,numeric"`
+	Page       *string `form:"page"       binding:"omitempty,numeric"`
+}
+
+type SigningStatisticsRequest struct {
+	Scale     *string `form:"scale"     binding:"omitempty,eq=hour|eq=day"`
+	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
+	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
+}
+
+type RewardsQueryRequest struct {
+	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
+	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
+}
+
+type RewardsStatisticsRequest struct {
+	Scale     *string `form:"scale"     binding:"omitempty,eq=minute|eq=hour|eq=day"`
+	Role      *string `form:"role"      binding:"omitempty,eq=Validator|eq=Delegator|eq=DAO|eq=Developers"`
+	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
+	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
+}
+
+type ValidatorTimelineRequest struct {
+	Cursor *string `form:"cursor" binding:"omitempty,paginationCursor"`
+	After  *string `form:"after"  binding:"omitempty,paginationAfterBlock"`
+}
+
+type ValidatorSetChangesRequest struct {
+	StartBlock *string `form:"startblock" binding:"omitempty,numeric"`
+	EndBlock   *string `form:"endblock"   binding:"omitempty,numeric"`
 }
 
 type CacheValidatorsData struct {
@@ -45,6 +105,9 @@ type CacheValidatorsData struct {
 // cache time
 const CacheBlocksCount = time.Duration(15)
 
+// cache time of delegator rewards for apy
+const ApyCacheTime = time.Duration(600)
+
 // Get list of transaction by validator public key
 func GetValidatorTransactions(c *gin.Context) {
 	var validatorRequest GetValidatorRequest
@@ -68,12 +131,19 @@ func GetValidatorTransactions(c *gin.Context) {
 
 	// fetch data
 	publicKey := helpers.RemovePrefix(validatorRequest.PublicKey)
-	pagination := tools.NewPagination(c.Request)
-	txs := explorer.TransactionRepository.GetPaginatedTxsByFilter(transaction.ValidatorFilter{
-		ValidatorPubKey: publicKey,
-		StartBlock:      request.StartBlock,
-		EndBlock:        request.EndBlock,
+	pagination := tools.NewCursorPagination(c.Request)
+	txs, err := explorer.TransactionRepository.GetPaginatedTxsByFilter(tools.Filters{
+		transaction.ValidatorFilter{
+			ValidatorPubKey: publicKey,
+			StartBlock:      request.StartBlock,
+			EndBlock:        request.EndBlock,
+		},
+		request.FilterRequest.Filter(),
 	}, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(txs, transaction.Resource{}, pagination))
 }
@@ -91,48 +161,180 @@ func GetValidator(c *gin.Context) {
 	}
 
 	// fetch data
-	data := explorer.ValidatorRepository.GetByPublicKey(helpers.RemovePrefix(request.PublicKey))
-
-	// check validator to existing
-	if data == nil {
-		errors.SetErrorResponse(http.StatusNotFound, http.StatusNotFound, "Validator not found.", c)
+	data, err := explorer.ValidatorRepository.GetByPublicKey(helpers.RemovePrefix(request.PublicKey))
+	if err != nil {
+		c.Error(errors.WithNotFoundMessage(err, "Validator not found."))
 		return
 	}
 
 	// get array of active validator ids by last block
-	activeValidatorIDs := getActiveValidatorIDs(explorer)
+	activeValidatorIDs, err := getActiveValidatorIDs(explorer)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
 	// get total stake of active validators
-	totalStake := getTotalStakeByActiveValidators(explorer, activeValidatorIDs)
+	totalStake, err := getTotalStakeByActiveValidators(explorer, activeValidatorIDs)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	delegatorRewards, err := getDelegatorRewards(explorer)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	c.JSON(http.StatusOK, gin.H{
 		"data": validator.Resource{}.Transform(*data, validator.Params{
 			TotalStake:          totalStake,
 			ActiveValidatorsIDs: activeValidatorIDs,
+			DelegatorRewards:    delegatorRewards,
 		}),
 	})
 }
 
+// Estimate delegator rewards of a stake in the coin by validator public key
+func GetCalculator(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetValidatorRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query CalculatorRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	amount, ok := new(big.Int).SetString(apiHelper.Noah2QNoahStr(query.Amount), 10)
+	if !ok || amount.Sign() <= 0 {
+		c.Error(errors.NewInvalidInput("Amount must be positive.", nil))
+		return
+	}
+
+	// fetch data
+	data, err := explorer.ValidatorRepository.GetByPublicKey(helpers.RemovePrefix(request.PublicKey))
+	if err != nil {
+		c.Error(errors.WithNotFoundMessage(err, "Validator not found."))
+		return
+	}
+
+	// custom coins are delegated by noah value of sale return
+	coin := explorer.Environment.BaseCoin
+	noahValue := amount
+	if query.Coin != nil && strings.ToUpper(*query.Coin) != coin {
+		coin = strings.ToUpper(*query.Coin)
+		noahValue, err = getNoahValue(explorer, coin, amount)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+	}
+
+	delegatorRewards, err := getDelegatorRewards(explorer)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	rewards, ok := delegatorRewards[data.ID]
+	if !ok {
+		rewards = "0"
+	}
+
+	totalStake := zero.StringFromPtr(data.TotalStake).String
+	days := validator.ApyDays(data.CreatedAt, apiHelper.StartOfTheDay(time.Now()))
+	estimate := validator.EstimateRewards(rewards, totalStake, days, noahValue)
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": validator.CalculatorResource{
+			Coin:      coin,
+			Amount:    apiHelper.QNoahStr2Noah(amount.String()),
+			NoahValue: apiHelper.QNoahStr2Noah(noahValue.String()),
+			Apy:       validator.GetValidatorApy(*data, delegatorRewards),
+			Daily:     apiHelper.QNoahStr2Noah(estimate.Daily.Text('f', 0)),
+			Monthly:   apiHelper.QNoahStr2Noah(estimate.Monthly.Text('f', 0)),
+			Yearly:    apiHelper.QNoahStr2Noah(estimate.Yearly.Text('f', 0)),
+		},
+	})
+}
+
+// Get base coin value of the coin amount by coin reserve
+func getNoahValue(explorer *core.Explorer, symbol string, amount *big.Int) (*big.Int, error) {
+	coin, err := explorer.CoinRepository.GetBySymbol(symbol)
+	if err != nil {
+		return nil, errors.WithNotFoundMessage(err, "Coin not found.")
+	}
+
+	curve, err := coins.NewCurve(*coin)
+	if err != nil {
+		return nil, err
+	}
+
+	return curve.NoahValue(amount)
+}
+
+// Get sums of delegator rewards by validator ids for the apy period
+func getDelegatorRewards(explorer *core.Explorer) (map[uint64]string, error) {
+	rewards, err := explorer.Cache.Get("validators_delegator_rewards", func() (interface{}, error) {
+		// full days of the period, today is not over yet
+		today := apiHelper.StartOfTheDay(time.Now())
+		since := today.AddDate(0, 0, -config.ValidatorApyPeriodInDays)
+		sums, err := explorer.RewardRepository.GetDelegatorRewardsByValidators(since, today)
+		if err != nil {
+			return nil, err
+		}
+
+		rewards := make(map[uint64]string, len(sums))
+		for _, sum := range sums {
+			rewards[sum.ValidatorID] = sum.Amount
+		}
+
+		return rewards, nil
+	}, ApyCacheTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return rewards.(map[uint64]string), nil
+}
+
 // Get IDs of active validators
-func getActiveValidatorIDs(explorer *core.Explorer) []uint64 {
-	return explorer.Cache.Get("active_validators", func() interface{} {
+func getActiveValidatorIDs(explorer *core.Explorer) ([]uint64, error) {
+	ids, err := explorer.Cache.Get("active_validators", func() (interface{}, error) {
 		return explorer.ValidatorRepository.GetActiveValidatorIds()
-	}, CacheBlocksCount).([]uint64)
+	}, CacheBlocksCount)
+	if err != nil {
+		return nil, err
+	}
+
+	return ids.([]uint64), nil
 }
 
 // Get total stake of active validators
-func getTotalStakeByActiveValidators(explorer *core.Explorer, validators []uint64) string {
-	return explorer.Cache.Get("validators_total_stake", func() interface{} {
+func getTotalStakeByActiveValidators(explorer *core.Explorer, validators []uint64) (string, error) {
+	totalStake, err := explorer.Cache.Get("validators_total_stake", func() (interface{}, error) {
 		return explorer.ValidatorRepository.GetTotalStakeByActiveValidators(validators)
-	}, CacheBlocksCount).(string)
+	}, CacheBlocksCount)
+	if err != nil {
+		return "", err
+	}
+
+	return totalStake.(string), nil
 }
 
-func getValidatorsWithPagination(c *gin.Context, req GetAggregatedValidatorRequest, pagination *tools.Pagination) []models.Validator {
+func getValidatorsWithPagination(c *gin.Context, req GetAggregatedValidatorRequest, pagination *tools.Pagination) ([]models.Validator, error) {
 	explorer := c.MustGet("explorer").(*core.Explorer)
-	var data []models.Validator
 
 	var field, orderBy *string
-	if req.Filter != nil && apiHelper.IsModelsContain(*req.Filter, []string{
-		"uptime", "total_stake", "commission", "count_delegators"}) {
+	if req.Filter != nil && apiHelper.IsModelsContain(*req.Filter, SortFields) {
 		field = req.Filter
 	}
 
@@ -140,23 +342,7 @@ func getValidatorsWithPagination(c *gin.Context, req GetAggregatedValidatorReque
 		orderBy = req.OrderBy
 	}
 
-	getValidators := func() []models.Validator {
-		return explorer.ValidatorRepository.GetValidatorsWithPagination(pagination, field, orderBy)
-	}
-
-	// cache last blocks
-	if pagination.GetCurrentPage() == 1 && pagination.GetPerPage() == tools.DefaultLimit {
-		//cached := explorer.Cache.Get("coins", func() interface{} {
-		//	return CacheCoinsData{getCoins(), pagination}
-		//}, CacheCoinsCount).(CacheCoinsData)
-		cached := CacheValidatorsData{getValidators(), *pagination}
-		data = cached.Validators
-		*pagination = cached.Pagination
-	} else {
-		data = getValidators()
-	}
-
-	return data
+	return explorer.ValidatorRepository.GetValidatorsWithPagination(pagination, field, orderBy)
 }
 
 func GetAggregatedValidators(c *gin.Context) {
@@ -169,11 +355,30 @@ func GetAggregatedValidators(c *gin.Context) {
 		return
 	}
 
-	activeValidatorIDs := getActiveValidatorIDs(explorer)
-	totalStakeActiveValidators := getTotalStakeByActiveValidators(explorer, activeValidatorIDs)
+	activeValidatorIDs, err := getActiveValidatorIDs(explorer)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	totalStakeActiveValidators, err := getTotalStakeByActiveValidators(explorer, activeValidatorIDs)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	delegatorRewards, err := getDelegatorRewards(explorer)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	pagination := tools.NewPagination(c.Request)
-	data := getValidatorsWithPagination(c, request, &pagination)
+	data, err := getValidatorsWithPagination(c, request, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	resources := make([]validator.ResourceAggregator, len(data))
 	for i, d := range data {
@@ -182,6 +387,7 @@ func GetAggregatedValidators(c *gin.Context) {
 			Status:          d.Status,
 			Meta:            new(meta.Resource).Transform(d),
 			Uptime:          d.Uptime,
+			Apy:             validator.GetValidatorApy(d, delegatorRewards),
 			CreatedAt:       d.CreatedAt.Format(time.RFC3339),
 			CountDelegators: d.CountDelegators,
 		}
@@ -217,11 +423,15 @@ func GetDelegators(c *gin.Context) {
 	}
 
 	pagination := tools.NewPagination(c.Request)
-	data := explorer.StakeRepository.GetPaginatedDelegatorsForValidator(helpers.RemovePrefix(request.PublicKey), &pagination)
+	data, err := explorer.StakeRepository.GetPaginatedDelegatorsForValidator(helpers.RemovePrefix(request.PublicKey), &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
 
 	// check validator to existing
 	if data == nil {
-		errors.SetErrorResponse(http.StatusNotFound, http.StatusNotFound, "Validator not found.", c)
+		c.Error(errors.NewNotFound("Validator not found."))
 		return
 	}
 
@@ -229,3 +439,365 @@ func GetDelegators(c *gin.Context) {
 		resource.TransformPaginatedCollection(data, stake.ResourceDelegatorsForValidator{}, pagination),
 	)
 }
+
+// Get list of blocks signed or missed by validator
+func GetValidatorBlocks(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetValidatorRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query FilterQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// fetch data
+	pagination := tools.NewPagination(c.Request)
+	data, err := explorer.BlockValidatorRepository.GetPaginatedByValidator(
+		helpers.RemovePrefix(request.PublicKey),
+		blocks.RangeSelectFilter{StartBlock: query.StartBlock, EndBlock: query.EndBlock},
+		&pagination,
+	)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(data, block_validator.Resource{}, pagination))
+}
+
+// Get signed and missed blocks statistics of validator
+func GetValidatorSigningStatistics(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetValidatorRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query SigningStatisticsRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// set defaults
+	scale := config.DefaultStatisticsScale
+	if query.Scale != nil {
+		scale = *query.Scale
+	}
+
+	startTime := apiHelper.StartOfTheDay(time.Now().AddDate(0, 0, config.DefaultStatisticsDayDelta))
+	if query.StartTime != nil {
+		startTime, _ = apiHelper.ParseTimestamp(*query.StartTime)
+	}
+
+	var endTime *time.Time
+	if query.EndTime != nil {
+		t, _ := apiHelper.ParseTimestamp(*query.EndTime)
+		endTime = &t
+	}
+
+	// fetch data
+	publicKey := helpers.RemovePrefix(request.PublicKey)
+	chartData, err := explorer.BlockValidatorRepository.GetChartData(publicKey, scale, startTime, endTime)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	longestStreak, err := explorer.BlockValidatorRepository.GetLongestMissedStreak(publicKey, startTime, endTime)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	currentStreak, err := explorer.BlockValidatorRepository.GetCurrentMissedStreak(publicKey)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": block_validator.StatisticsResource{}.Transform(block_validator.Statistics{
+			Chart:               chartData,
+			LongestMissedStreak: longestStreak,
+			CurrentMissedStreak: currentStreak,
+		}),
+	})
+}
+
+// Get list of slashes of validator delegators
+func GetValidatorSlashes(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetValidatorRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query FilterQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// fetch data
+	pagination := tools.NewPagination(c.Request)
+	slashes, err := explorer.SlashRepository.GetPaginatedByValidator(events.ValidatorSelectFilter{
+		ValidatorPubKey: helpers.RemovePrefix(request.PublicKey),
+		StartBlock:      query.StartBlock,
+		EndBlock:        query.EndBlock,
+	}, &pagination)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(slashes, slash.Resource{}, pagination))
+}
+
+// Get daily rewards paid by validator split by roles
+func GetValidatorDailyRewards(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetValidatorRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query RewardsQueryRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// fetch data
+	rewards, err := explorer.RewardRepository.GetDailyByValidator(aggregated_reward.ValidatorSelectFilter{
+		ValidatorPubKey: helpers.RemovePrefix(request.PublicKey),
+		StartTime:       query.StartTime,
+		EndTime:         query.EndTime,
+	})
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resource.TransformCollection(reward.TransformDailyByRoles(rewards), reward.DailyResource{}),
+	})
+}
+
+// Get chart of rewards paid by validator
+func GetValidatorRewardsStatistics(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetValidatorRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query RewardsStatisticsRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// set defaults
+	scale := config.DefaultStatisticsScale
+	if query.Scale != nil {
+		scale = *query.Scale
+	}
+
+	startTime := apiHelper.StartOfTheDay(time.Now().AddDate(0, 0, config.DefaultStatisticsDayDelta)).Format("2006-01-02 15:04:05")
+	if query.StartTime != nil {
+		startTime = *query.StartTime
+	}
+
+	// fetch data
+	chartData, err := explorer.RewardRepository.GetChartDataByValidator(
+		helpers.RemovePrefix(request.PublicKey),
+		zero.StringFromPtr(query.Role).String,
+		chart.SelectFilter{
+			Scale:     scale,
+			StartTime: &startTime,
+			EndTime:   query.EndTime,
+		},
+	)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resource.TransformCollection(chartData, chart.RewardResource{}),
+	})
+}
+
+// Get lifecycle transactions, slashes and validator set changes of validator from the latest
+func GetValidatorTimeline(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request GetValidatorRequest
+	if err := c.ShouldBindUri(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	var query ValidatorTimelineRequest
+	if err := c.ShouldBindQuery(&query); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	// entries are paginated by block height cursor only
+	pagination := tools.NewCursorPagination(c.Request)
+	pagination.Keyset = true
+	limit := pagination.GetPerPage() + 1
+
+	// fetch data
+	publicKey := helpers.RemovePrefix(request.PublicKey)
+	txs, err := explorer.ValidatorTimelineRepository.GetTransactions(publicKey, pagination.Cursor, limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	slashes, err := explorer.ValidatorTimelineRepository.GetSlashes(publicKey, pagination.Cursor, limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	changes, err := explorer.ValidatorTimelineRepository.GetSetChanges(publicKey, pagination.Cursor, limit)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	entries := timeline.Merge(txs, slashes, changes)
+	blocks := make([]uint64, len(entries))
+	for i, entry := range entries {
+		blocks[i] = entry.BlockID
+	}
+
+	end, nextCursor := tools.PageByBlocks(blocks, pagination.GetPerPage())
+	pagination.NextCursor = nextCursor
+
+	c.JSON(http.StatusOK, resource.TransformPaginatedCollection(entries[:end], timeline.Resource{}, pagination))
+}
+
+// Get entries to and exits from the validator set in blocks range, the latest blocks by default
+func GetValidatorSetChanges(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	// validate request
+	var request ValidatorSetChangesRequest
+	if err := c.ShouldBindQuery(&request); err != nil {
+		errors.SetValidationErrorResponse(err, c)
+		return
+	}
+
+	lastBlock, err := explorer.BlockRepository.GetLastBlock()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// set default range
+	endBlock := lastBlock.ID
+	if request.EndBlock != nil {
+		if endBlock, err = strconv.ParseUint(*request.EndBlock, 10, 64); err != nil {
+			c.Error(errors.NewInvalidInput("Invalid end block height.", err))
+			return
+		}
+	}
+
+	startBlock := uint64(1)
+	if endBlock > config.ValidatorSetChangesDefaultBlocks {
+		startBlock = endBlock - config.ValidatorSetChangesDefaultBlocks + 1
+	}
+
+	if request.StartBlock != nil {
+		if startBlock, err = strconv.ParseUint(*request.StartBlock, 10, 64); err != nil {
+			c.Error(errors.NewInvalidInput("Invalid start block height.", err))
+			return
+		}
+	}
+
+	if startBlock > endBlock || endBlock-startBlock >= config.ValidatorSetChangesMaxBlocks {
+		c.Error(errors.NewInvalidInput(fmt.Sprintf("Blocks range must not exceed %d blocks.", config.ValidatorSetChangesMaxBlocks), nil))
+		return
+	}
+
+	// fetch data
+	changes, err := explorer.BlockValidatorRepository.GetSetChanges(startBlock, endBlock)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"data": resource.TransformCollection(changes, block_validator.SetChangeResource{}),
+	})
+}
+
+// Get size of the validator set for the next block and minimal stake to get into it
+func GetValidatorSetThreshold(c *gin.Context) {
+	explorer := c.MustGet("explorer").(*core.Explorer)
+
+	lastBlock, err := explorer.BlockRepository.GetLastBlock()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	activeValidatorIDs, err := getActiveValidatorIDs(explorer)
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	candidatesCount, err := explorer.ValidatorRepository.GetActiveCandidatesCount()
+	if err != nil {
+		c.Error(err)
+		return
+	}
+
+	// the weakest candidate within the set size defines the threshold when candidates compete for places
+	validatorsCount := nodeValidators.GetValidatorsCountForBlock(lastBlock.ID + 1)
+	data := validator.ThresholdResource{
+		ValidatorsCount:       validatorsCount,
+		ActiveValidatorsCount: len(activeValidatorIDs),
+		CandidatesCount:       candidatesCount,
+	}
+
+	if candidatesCount > validatorsCount {
+		stake, err := explorer.ValidatorRepository.GetCandidateStakeAtPosition(validatorsCount)
+		if err != nil {
+			c.Error(err)
+			return
+		}
+
+		if stake != nil {
+			data.MinStake = pointer.ToString(apiHelper.QNoahStr2Noah(*stake))
+		}
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": data})
+}
@@ -10,6 +10,19 @@ func ApplyRoutes(r *gin.RouterGroup) {
 		validators.GET("/:publicKey/transactions", GetValidatorTransactions)
 		validators.GET("/:publicKey", GetValidator)
 		validators.GET("/:publicKey/delegators", GetDelegators)
+		validators.GET("/:publicKey/calculator", GetCalculator)
+		validators.GET("/:publicKey/blocks", GetValidatorBlocks)
+		validators.GET("/:publicKey/statistics/signing", GetValidatorSigningStatistics)
+		validators.GET("/:publicKey/events/slashes", GetValidatorSlashes)
+		validators.GET("/:publicKey/rewards/daily", GetValidatorDailyRewards)
+		validators.GET("/:publicKey/statistics/rewards", GetValidatorRewardsStatistics)
+		validators.GET("/:publicKey/timeline", GetValidatorTimeline)
 		//validators.GET("/ull", GetValidatorsFull)
 	}
+
+	validatorsSet := r.Group("/validators-set")
+	{
+		validatorsSet.GET("/changes", GetValidatorSetChanges)
+		validatorsSet.GET("/threshold", GetValidatorSetThreshold)
+	}
 }
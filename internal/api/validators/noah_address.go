@@ -27,3 +27,8 @@ func NoahAddress(
 func isValidNoahAddress(address string) bool {
 	return regexp.MustCompile("^NOAHx([A-Fa-f0-9]{40})$").MatchString(address)
 }
+
+// Check if string is noah address with NOAHx prefix
+func IsNoahAddress(address string) bool {
+	return isValidNoahAddress(address)
+}
@@ -16,3 +16,8 @@ func NoahTxHash(
 func isValidNoahHash(hash string) bool {
 	return regexp.MustCompile("^Nt([A-Fa-f0-9]{64})$").MatchString(hash)
 }
+
+// Check if string is transaction hash with Nt prefix
+func IsNoahTxHash(hash string) bool {
+	return isValidNoahHash(hash)
+}
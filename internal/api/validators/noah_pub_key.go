@@ -16,3 +16,8 @@ func NoahPublicKey(
 func isValidNoahPublicKey(publicKey string) bool {
 	return regexp.MustCompile("^Np([A-Fa-f0-9]{64})$").MatchString(publicKey)
 }
+
+// Check if string is validator public key with Np prefix
+func IsNoahPublicKey(publicKey string) bool {
+	return isValidNoahPublicKey(publicKey)
+}
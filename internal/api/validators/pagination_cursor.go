@@ -0,0 +1,26 @@
+package validators
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
+	"gopkg.in/go-playground/validator.v8"
+)
+
+func PaginationCursor(
+	v *validator.Validate, topStruct reflect.Value, currentStructOrField reflect.Value,
+	field reflect.Value, fieldType reflect.Type, fieldKind reflect.Kind, param string,
+) bool {
+	_, err := tools.DecodeCursor(field.String())
+	return err == nil
+}
+
+// Check if string is a positive row id, zero id would silently start from the first page
+func PaginationAfter(
+	v *validator.Validate, topStruct reflect.Value, currentStructOrField reflect.Value,
+	field reflect.Value, fieldType reflect.Type, fieldKind reflect.Kind, param string,
+) bool {
+	id, err := strconv.ParseUint(field.String(), 10, 64)
+	return err == nil && id > 0
+}
@@ -0,0 +1,15 @@
+package validators
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPaginationAfter(t *testing.T) {
+	cases := map[string]bool{"1": true, "12345": true, "0": false, "-1": false, "abc": false}
+	for after, valid := range cases {
+		if PaginationAfter(nil, reflect.Value{}, reflect.Value{}, reflect.ValueOf(after), nil, reflect.String, "") != valid {
+			t.Errorf("expected after %q to be valid: %v", after, valid)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package balance
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/transaction/data_resources"
+)
+
+// fee units in qNoah
+var feeMultiplier = big.NewInt(1000000000000000)
+
+// Change of address balance of coin by transaction in qNoah
+type TxDelta struct {
+	Coin  string
+	Value *big.Int
+}
+
+// Changes of address balances by transaction sorted by coins and the coin of commission excluded from them,
+// empty if none. The commission in a custom coin is the sale amount of the fee by the coin reserve at the block,
+// so it can not be derived from the transaction. The sender pays the fee except for redeemed checks
+// where the issuer pays it. Unbonded stake returns to the balance separately after the unbond period.
+func TxDeltas(tx models.Transaction, address string, baseCoin string) ([]TxDelta, string, error) {
+	values := make(map[string]*big.Int)
+	add := func(coin string, value string, sign int) error {
+		amount, err := parseValue(value)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := values[coin]; !ok {
+			values[coin] = new(big.Int)
+		}
+
+		if sign < 0 {
+			amount.Neg(amount)
+		}
+
+		values[coin].Add(values[coin], amount)
+		return nil
+	}
+
+	excluded := ""
+	sent := tx.FromAddress != nil && tx.FromAddress.Address == address
+	if sent && tx.Type != models.TxTypeRedeemCheck {
+		if tx.GasCoin.Symbol != baseCoin {
+			excluded = tx.GasCoin.Symbol
+		} else if err := add(baseCoin, fee(tx).String(), -1); err != nil {
+			return nil, "", err
+		}
+	}
+
+	var err error
+	switch tx.Type {
+	case models.TxTypeSend:
+		var data models.SendTxData
+		if err = unmarshalData(tx, &data); err == nil {
+			err = addTransfers(add, []models.SendTxData{data}, sent, address)
+		}
+	case models.TxTypeMultiSend:
+		var data models.MultiSendTxData
+		if err = unmarshalData(tx, &data); err == nil {
+			err = addTransfers(add, data.List, sent, address)
+		}
+	}
+
+	if sent {
+		switch tx.Type {
+		case models.TxTypeSellCoin:
+			var data models.SellCoinTxData
+			if err = unmarshalData(tx, &data); err == nil {
+				err = addConversion(add, data.CoinToSell, data.ValueToSell, data.CoinToBuy, tx.Tags["tx.return"])
+			}
+		case models.TxTypeSellAllCoin:
+			var data models.SellAllCoinTxData
+			if err = unmarshalData(tx, &data); err == nil {
+				err = addConversion(add, data.CoinToSell, tx.Tags["tx.sell_amount"], data.CoinToBuy, tx.Tags["tx.return"])
+			}
+		case models.TxTypeBuyCoin:
+			var data models.BuyCoinTxData
+			if err = unmarshalData(tx, &data); err == nil {
+				err = addConversion(add, data.CoinToSell, tx.Tags["tx.return"], data.CoinToBuy, data.ValueToBuy)
+			}
+		case models.TxTypeCreateCoin:
+			var data models.CreateCoinTxData
+			if err = unmarshalData(tx, &data); err == nil {
+				err = addConversion(add, baseCoin, data.InitialReserve, data.Symbol, data.InitialAmount)
+			}
+		case models.TxTypeDeclareCandidacy:
+			var data models.DeclareCandidacyTxData
+			if err = unmarshalData(tx, &data); err == nil {
+				err = add(data.Coin, data.Stake, -1)
+			}
+		case models.TxTypeDelegate:
+			var data models.DelegateTxData
+			if err = unmarshalData(tx, &data); err == nil {
+				err = add(data.Coin, data.Value, -1)
+			}
+		}
+	}
+
+	if tx.Type == models.TxTypeRedeemCheck {
+		var checkExcluded string
+		if checkExcluded, err = addCheck(add, tx, sent, address, baseCoin); checkExcluded != "" {
+			excluded = checkExcluded
+		}
+	}
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	deltas := make([]TxDelta, 0, len(values))
+	for coin, value := range values {
+		if value.Sign() != 0 {
+			deltas = append(deltas, TxDelta{Coin: coin, Value: value})
+		}
+	}
+
+	sort.Slice(deltas, func(i, j int) bool {
+		return deltas[i].Coin < deltas[j].Coin
+	})
+
+	return deltas, excluded, nil
+}
+
+type addFunc func(coin string, value string, sign int) error
+
+// Outputs to address are received, all outputs are paid by the sender
+func addTransfers(add addFunc, list []models.SendTxData, sent bool, address string) error {
+	for _, item := range list {
+		if sent {
+			if err := add(item.Coin, item.Value, -1); err != nil {
+				return err
+			}
+		}
+
+		if len(item.To) > 5 && helpers.RemoveNoahPrefix(item.To) == address {
+			if err := add(item.Coin, item.Value, 1); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func addConversion(add addFunc, coinToSell string, valueToSell string, coinToBuy string, valueToBuy string) error {
+	if err := add(coinToSell, valueToSell, -1); err != nil {
+		return err
+	}
+
+	return add(coinToBuy, valueToBuy, 1)
+}
+
+// The redeemer gets the value of check and the issuer pays it with the commission in the check coin,
+// returns the check coin if the commission is excluded as the check coin is a custom coin
+func addCheck(add addFunc, tx models.Transaction, sent bool, address string, baseCoin string) (string, error) {
+	var data models.RedeemCheckTxData
+	if err := unmarshalData(tx, &data); err != nil {
+		return "", err
+	}
+
+	check, err := data_resources.TransformCheckData(data.RawCheck)
+	if err != nil {
+		return "", err
+	}
+
+	value := helpers.Noah2QNoahStr(check.Value)
+	if sent {
+		if err := add(check.Coin, value, 1); err != nil {
+			return "", err
+		}
+	}
+
+	if check.Sender != "NOAHx"+address {
+		return "", nil
+	}
+
+	if err := add(check.Coin, value, -1); err != nil {
+		return "", err
+	}
+
+	if check.Coin != baseCoin {
+		return check.Coin, nil
+	}
+
+	return "", add(check.Coin, fee(tx).String(), -1)
+}
+
+// Fee of transaction in qNoah of the base coin
+func fee(tx models.Transaction) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(tx.GetFee()), feeMultiplier)
+}
+
+func unmarshalData(tx models.Transaction, data interface{}) error {
+	if err := json.Unmarshal(tx.Data, data); err != nil {
+		return errors.NewMalformedData(fmt.Sprintf("Invalid data of transaction %s", tx.GetHash()), err)
+	}
+
+	return nil
+}
+
+func parseValue(value string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, errors.NewMalformedData(fmt.Sprintf("Invalid balance change %s", value), nil)
+	}
+
+	return amount, nil
+}
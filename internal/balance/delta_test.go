@@ -0,0 +1,150 @@
+package balance
+
+import (
+	"encoding/base64"
+	"math/big"
+	"testing"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-go-node/core/check"
+	"github.com/noah-blockchain/noah-go-node/core/types"
+	"github.com/noah-blockchain/noah-go-node/crypto"
+	"github.com/noah-blockchain/noah-go-node/rlp"
+)
+
+const (
+	sender    = "1111111111111111111111111111111111111111"
+	recipient = "2222222222222222222222222222222222222222"
+)
+
+func tx(txType uint8, from string, data string, tags map[string]string) models.Transaction {
+	return models.Transaction{
+		Type:        txType,
+		Gas:         10,
+		GasPrice:    1,
+		Data:        []byte(data),
+		Tags:        tags,
+		FromAddress: &models.Address{Address: from},
+		GasCoin:     &models.Coin{Symbol: "NOAH"},
+	}
+}
+
+func assertDeltas(t *testing.T, deltas []TxDelta, expected map[string]string) {
+	if len(deltas) != len(expected) {
+		t.Fatalf("expected %d deltas, got %v", len(expected), deltas)
+	}
+
+	for _, delta := range deltas {
+		if delta.Value.String() != expected[delta.Coin] {
+			t.Fatalf("expected delta %s of %s, got %s", expected[delta.Coin], delta.Coin, delta.Value)
+		}
+	}
+}
+
+func TestTxDeltasOfTransfer(t *testing.T) {
+	send := tx(models.TxTypeSend, sender, `{"coin":"TEST","to":"NOAHx`+recipient+`","value":"500"}`, nil)
+
+	deltas, _, err := TxDeltas(send, sender, "NOAH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertDeltas(t, deltas, map[string]string{"NOAH": "-10000000000000000", "TEST": "-500"})
+
+	deltas, _, err = TxDeltas(send, recipient, "NOAH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertDeltas(t, deltas, map[string]string{"TEST": "500"})
+}
+
+func TestTxDeltasExcludeFeeInCustomCoin(t *testing.T) {
+	send := tx(models.TxTypeSend, sender, `{"coin":"TEST","to":"NOAHx`+recipient+`","value":"500"}`, nil)
+	send.GasCoin = &models.Coin{Symbol: "TEST"}
+
+	deltas, excluded, err := TxDeltas(send, sender, "NOAH")
+	if err != nil || excluded != "TEST" {
+		t.Fatalf("expected fee in custom coin to be excluded, got %v %v", excluded, err)
+	}
+	assertDeltas(t, deltas, map[string]string{"TEST": "-500"})
+}
+
+func TestTxDeltasOfCreateCoinAndCandidacy(t *testing.T) {
+	create := tx(models.TxTypeCreateCoin, sender, `{"symbol":"TEST","initial_amount":"700","initial_reserve":"200"}`, nil)
+
+	deltas, _, err := TxDeltas(create, sender, "NOAH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertDeltas(t, deltas, map[string]string{"NOAH": "-10000000000000200", "TEST": "700"})
+
+	declare := tx(models.TxTypeDeclareCandidacy, sender, `{"coin":"TEST","stake":"100"}`, nil)
+	declare.GasCoin = &models.Coin{Symbol: "TEST"}
+
+	deltas, excluded, err := TxDeltas(declare, sender, "NOAH")
+	if err != nil || excluded != "TEST" {
+		t.Fatalf("expected fee in custom coin to be excluded, got %v %v", excluded, err)
+	}
+	assertDeltas(t, deltas, map[string]string{"TEST": "-100"})
+}
+
+func TestTxDeltasOfConversion(t *testing.T) {
+	sell := tx(models.TxTypeSellAllCoin, sender, `{"coin_to_sell":"NOAH","coin_to_buy":"TEST"}`,
+		map[string]string{"tx.sell_amount": "990000000000000000", "tx.return": "300"})
+
+	deltas, _, err := TxDeltas(sell, sender, "NOAH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertDeltas(t, deltas, map[string]string{"NOAH": "-1000000000000000000", "TEST": "300"})
+}
+
+// Redeem check transaction of check in coin issued by a new key, returns the issuer address
+func redeemCheckTx(t *testing.T, coin string, value int64) (models.Transaction, string) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := check.Check{
+		Nonce:    []byte{1},
+		ChainID:  types.CurrentChainID,
+		DueBlock: 100,
+		Coin:     types.StrToCoinSymbol(coin),
+		Value:    big.NewInt(value),
+		Lock:     big.NewInt(0),
+	}
+	if err := c.Sign(key); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err := rlp.EncodeToBytes(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := `{"raw_check":"` + base64.StdEncoding.EncodeToString(raw) + `","proof":""}`
+	return tx(models.TxTypeRedeemCheck, recipient, data, nil), crypto.PubkeyToAddress(key.PublicKey).String()[5:]
+}
+
+func TestTxDeltasOfRedeemCheck(t *testing.T) {
+	redeem, issuer := redeemCheckTx(t, "NOAH", 500)
+
+	deltas, excluded, err := TxDeltas(redeem, issuer, "NOAH")
+	if err != nil || excluded != "" {
+		t.Fatalf("expected deltas with commission, got %v %v", excluded, err)
+	}
+	assertDeltas(t, deltas, map[string]string{"NOAH": "-10000000000000500"})
+
+	deltas, _, err = TxDeltas(redeem, recipient, "NOAH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertDeltas(t, deltas, map[string]string{"NOAH": "500"})
+
+	redeem, issuer = redeemCheckTx(t, "TEST", 500)
+	deltas, excluded, err = TxDeltas(redeem, issuer, "NOAH")
+	if err != nil || excluded != "TEST" {
+		t.Fatalf("expected commission in custom coin to be excluded, got %v %v", excluded, err)
+	}
+	assertDeltas(t, deltas, map[string]string{"TEST": "-500"})
+}
@@ -0,0 +1,192 @@
+package balance
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+)
+
+const precision = 500
+
+// Balance of coin at the end of period, the commission excluded from later changes makes it approximate
+type Point struct {
+	Time               time.Time
+	Value              string
+	CommissionExcluded bool
+}
+
+// Change of balance of coin by transaction, the commission in the coin may be excluded from it
+type Change struct {
+	BlockID            uint64
+	CreatedAt          time.Time
+	Coin               string
+	Value              string
+	CommissionExcluded bool
+}
+
+// Changes of address balances by transactions
+func TxChanges(txs []models.Transaction, address string, baseCoin string) ([]Change, error) {
+	changes := make([]Change, 0, len(txs))
+	for _, tx := range txs {
+		deltas, excluded, err := TxDeltas(tx, address, baseCoin)
+		if err != nil {
+			return nil, err
+		}
+
+		flagged := false
+		for _, delta := range deltas {
+			flagged = flagged || delta.Coin == excluded
+			changes = append(changes, Change{tx.BlockID, tx.CreatedAt, delta.Coin, delta.Value.String(), delta.Coin == excluded})
+		}
+
+		if excluded != "" && !flagged {
+			changes = append(changes, Change{tx.BlockID, tx.CreatedAt, excluded, "0", true})
+		}
+	}
+
+	return changes, nil
+}
+
+// Balances at the block by current balances and sums of changes after the block
+// and coins of balances with the commission excluded from the changes
+func AtBlock(current []*models.Balance, deltas []Delta, changes []Change) ([]models.Balance, map[string]bool, error) {
+	values := make(map[string]*big.Float)
+	add := func(coin string, value string, sign int) error {
+		amount, ok := new(big.Float).SetPrec(precision).SetString(value)
+		if !ok {
+			return errors.NewMalformedData(fmt.Sprintf("Invalid balance change %s of %s", value, coin), nil)
+		}
+
+		if _, ok := values[coin]; !ok {
+			values[coin] = new(big.Float).SetPrec(precision)
+		}
+
+		if sign < 0 {
+			amount.Neg(amount)
+		}
+
+		values[coin].Add(values[coin], amount)
+		return nil
+	}
+
+	for _, balance := range current {
+		if err := add(balance.Coin.Symbol, balance.Value, 1); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	for _, delta := range deltas {
+		if err := add(delta.Coin, delta.Value, -1); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	excluded := make(map[string]bool)
+	for _, change := range changes {
+		if err := add(change.Coin, change.Value, -1); err != nil {
+			return nil, nil, err
+		}
+
+		if change.CommissionExcluded {
+			excluded[change.Coin] = true
+		}
+	}
+
+	balances := make([]models.Balance, 0, len(values))
+	for coin, value := range values {
+		if value.Sign() <= 0 {
+			continue
+		}
+
+		balances = append(balances, models.Balance{Coin: &models.Coin{Symbol: coin}, Value: value.Text('f', 0)})
+	}
+
+	sort.Slice(balances, func(i, j int) bool {
+		return balances[i].Coin.Symbol < balances[j].Coin.Symbol
+	})
+
+	return balances, excluded, nil
+}
+
+// Balance of coin at the end of each period with changes from the earliest,
+// periods of changes are walked back from the current balance, so the commission excluded
+// from changes of a period makes the balances at the end of earlier periods approximate
+func History(current string, coin string, deltas []PeriodDelta, changes []Change, scale string) ([]Point, error) {
+	sums := make(map[time.Time]*big.Float)
+	add := func(period time.Time, value string) error {
+		amount, ok := new(big.Float).SetPrec(precision).SetString(value)
+		if !ok {
+			return errors.NewMalformedData(fmt.Sprintf("Invalid balance change %s", value), nil)
+		}
+
+		if _, ok := sums[period]; !ok {
+			sums[period] = new(big.Float).SetPrec(precision)
+		}
+
+		sums[period].Add(sums[period], amount)
+		return nil
+	}
+
+	for _, delta := range deltas {
+		if err := add(delta.Time.UTC(), delta.Value); err != nil {
+			return nil, err
+		}
+	}
+
+	var excludedIn *time.Time
+	for _, change := range changes {
+		if change.Coin != coin {
+			continue
+		}
+
+		period := Truncate(change.CreatedAt, scale)
+		if err := add(period, change.Value); err != nil {
+			return nil, err
+		}
+
+		if change.CommissionExcluded && (excludedIn == nil || period.After(*excludedIn)) {
+			excludedIn = &period
+		}
+	}
+
+	periods := make([]time.Time, 0, len(sums))
+	for period := range sums {
+		periods = append(periods, period)
+	}
+
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].After(periods[j])
+	})
+
+	balance, ok := new(big.Float).SetPrec(precision).SetString(current)
+	if !ok {
+		return nil, errors.NewMalformedData(fmt.Sprintf("Invalid balance %s", current), nil)
+	}
+
+	points := make([]Point, len(periods))
+	for i, period := range periods {
+		excluded := excludedIn != nil && period.Before(*excludedIn)
+		points[len(periods)-1-i] = Point{Time: period, Value: balance.Text('f', 0), CommissionExcluded: excluded}
+		balance.Sub(balance, sums[period])
+	}
+
+	return points, nil
+}
+
+// Start of period like date_trunc of PostgreSQL in UTC
+func Truncate(t time.Time, scale string) time.Time {
+	t = t.UTC()
+	switch scale {
+	case "minute":
+		return t.Truncate(time.Minute)
+	case "hour":
+		return t.Truncate(time.Hour)
+	}
+
+	return helpers.StartOfTheDay(t)
+}
@@ -0,0 +1,91 @@
+package balance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+)
+
+func TestAtBlock(t *testing.T) {
+	current := []*models.Balance{
+		{Coin: &models.Coin{Symbol: "NOAH"}, Value: "1000"},
+		{Coin: &models.Coin{Symbol: "TEST"}, Value: "50"},
+	}
+
+	deltas := []Delta{
+		{Coin: "NOAH", Value: "300"},
+		{Coin: "TEST", Value: "50"},
+		{Coin: "OLD", Value: "-20"},
+	}
+
+	changes := []Change{{Coin: "NOAH", Value: "-100"}, {Coin: "OLD", Value: "0", CommissionExcluded: true}}
+
+	balances, excluded, err := AtBlock(current, deltas, changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !excluded["OLD"] || excluded["NOAH"] {
+		t.Fatalf("expected commission of OLD only to be excluded, got %v", excluded)
+	}
+
+	expected := map[string]string{"NOAH": "800", "OLD": "20"}
+	if len(balances) != len(expected) {
+		t.Fatalf("expected %d balances, got %d", len(expected), len(balances))
+	}
+
+	for _, b := range balances {
+		if expected[b.Coin.Symbol] != b.Value {
+			t.Fatalf("expected %s of %s, got %s", expected[b.Coin.Symbol], b.Coin.Symbol, b.Value)
+		}
+	}
+
+	if balances[0].Coin.Symbol != "NOAH" {
+		t.Fatalf("balances are not sorted by coin")
+	}
+}
+
+func TestHistory(t *testing.T) {
+	day := time.Date(2019, 10, 1, 0, 0, 0, 0, time.UTC)
+	deltas := []PeriodDelta{
+		{Time: day.AddDate(0, 0, 2), Value: "-50"},
+		{Time: day, Value: "100"},
+	}
+
+	changes := []Change{
+		{CreatedAt: day.Add(5 * time.Hour), Coin: "NOAH", Value: "20"},
+		{CreatedAt: day.Add(30 * time.Hour), Coin: "TEST", Value: "10", CommissionExcluded: true},
+		{CreatedAt: day.Add(50 * time.Hour), Coin: "NOAH", Value: "0", CommissionExcluded: true},
+	}
+
+	points, err := History("70", "NOAH", deltas, changes, "day")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []Point{{day, "120", true}, {day.AddDate(0, 0, 2), "70", false}}
+	if len(points) != len(expected) {
+		t.Fatalf("expected %d points, got %d", len(expected), len(points))
+	}
+
+	for i, point := range points {
+		if !point.Time.Equal(expected[i].Time) || point.Value != expected[i].Value || point.CommissionExcluded != expected[i].CommissionExcluded {
+			t.Fatalf("expected %v, got %v", expected[i], point)
+		}
+	}
+}
+
+func TestTxChangesFlagExcludedCommission(t *testing.T) {
+	online := tx(models.TxTypeSetCandidateOnline, sender, `{"pub_key":"Np00"}`, nil)
+	online.GasCoin = &models.Coin{Symbol: "TEST"}
+
+	changes, err := TxChanges([]models.Transaction{online}, sender, "NOAH")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(changes) != 1 || changes[0].Coin != "TEST" || changes[0].Value != "0" || !changes[0].CommissionExcluded {
+		t.Fatalf("expected flagged zero change of TEST, got %v", changes)
+	}
+}
@@ -0,0 +1,103 @@
+package balance
+
+import (
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+)
+
+type Repository struct {
+	db             *pg.DB
+	baseCoinSymbol string
+}
+
+func NewRepository(db *pg.DB, baseCoinSymbol string) *Repository {
+	return &Repository{
+		db:             db,
+		baseCoinSymbol: baseCoinSymbol,
+	}
+}
+
+// Changes of balances of address by rewards and returns of unbonded stakes by blocks,
+// params are address, base coin symbol, unbond period and unbond transaction type.
+// Changes by transactions are derived from their data by TxDeltas.
+const deltasSql = `
+WITH address AS (
+	SELECT id FROM addresses WHERE address = ?0
+), deltas (block_id, coin, value) AS (
+	SELECT t.block_id + ?2, t.data->>'coin', (t.data->>'value')::numeric
+	FROM transactions AS t
+	WHERE t.from_address_id = (SELECT id FROM address) AND t.type = ?3
+		AND t.block_id + ?2 <= (SELECT MAX(id) FROM blocks)
+	UNION ALL
+	SELECT r.block_id, ?1, r.amount
+	FROM rewards AS r WHERE r.address_id = (SELECT id FROM address)
+)`
+
+// Sum of balance changes of coin
+type Delta struct {
+	Coin  string
+	Value string
+}
+
+// Sum of balance changes of coin in period
+type PeriodDelta struct {
+	Time  time.Time
+	Value string
+}
+
+// Get sums of balance changes by coins after the block
+func (repository Repository) GetDeltasAfterBlock(address string, blockId uint64) ([]Delta, error) {
+	var deltas []Delta
+
+	_, err := repository.db.Query(&deltas, deltasSql+`
+		SELECT coin, SUM(value) AS value FROM deltas WHERE block_id > ?4 GROUP BY coin`,
+		address, repository.baseCoinSymbol, config.UnbondPeriodInBlocks, models.TxTypeUnbound, blockId)
+
+	return deltas, err
+}
+
+// Get sums of balance changes of coin by UTC periods of the scale since the start of period
+func (repository Repository) GetDeltasByPeriods(address string, coin string, scale string, since time.Time) ([]PeriodDelta, error) {
+	var deltas []PeriodDelta
+
+	_, err := repository.db.Query(&deltas, deltasSql+`
+		SELECT date_trunc(?4, b.created_at AT TIME ZONE 'UTC') AS time, SUM(d.value) AS value
+		FROM deltas AS d INNER JOIN blocks AS b ON b.id = d.block_id
+		WHERE d.coin = ?5 AND b.created_at >= ?6
+		GROUP BY time`,
+		address, repository.baseCoinSymbol, config.UnbondPeriodInBlocks, models.TxTypeUnbound, scale, coin, since)
+
+	return deltas, err
+}
+
+// Get transactions of address after the block
+func (repository Repository) GetTransactionsAfterBlock(address string, blockId uint64) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	err := repository.transactionsQuery(&transactions, address).
+		Where("transaction.block_id > ?", blockId).
+		Select()
+
+	return transactions, err
+}
+
+// Get transactions of address since the time
+func (repository Repository) GetTransactionsSince(address string, since time.Time) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	err := repository.transactionsQuery(&transactions, address).
+		Where("transaction.created_at >= ?", since).
+		Select()
+
+	return transactions, err
+}
+
+func (repository Repository) transactionsQuery(transactions *[]models.Transaction, address string) *orm.Query {
+	return repository.db.Model(transactions).
+		Column("transaction.*", "FromAddress.address", "GasCoin.symbol").
+		Where("transaction.id IN (SELECT transaction_id FROM index_transaction_by_address WHERE address_id = (SELECT id FROM addresses WHERE address = ?))", address)
+}
@@ -3,21 +3,35 @@ package balance
 import (
 	"github.com/noah-blockchain/coinExplorer-tools/models"
 	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/market"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
 )
 
 type Resource struct {
-	Coin   string `json:"coin"`
-	Amount string `json:"amount"`
+	Coin               string  `json:"coin"`
+	Amount             string  `json:"amount"`
+	FiatValue          *string `json:"fiat_value"`
+	CommissionExcluded bool    `json:"commission_excluded,omitempty"`
 }
 
 func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
 	balance := model.(models.Balance)
 
-	return Resource{
+	res := Resource{
 		Coin:   balance.Coin.Symbol,
 		Amount: helpers.QNoahStr2Noah(balance.Value),
 	}
+
+	if len(params) > 0 {
+		res.FiatValue = params[0].(market.Rates).CoinValue(balance.Coin, balance.Value)
+	}
+
+	// balance at the block is approximate if a commission in its coin is excluded from later changes
+	if len(params) > 1 {
+		res.CommissionExcluded = params[1].(bool)
+	}
+
+	return res
 }
 
 type ResourceCoinAddressBalances struct {
@@ -0,0 +1,153 @@
+package block_validator
+
+import (
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/blocks"
+	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
+)
+
+type Repository struct {
+	db *pg.DB
+}
+
+func NewRepository(db *pg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Signed and missed blocks of validator in period
+type ChartData struct {
+	Time   time.Time
+	Signed uint64
+	Missed uint64
+}
+
+// Consecutive blocks missed by validator
+type Streak struct {
+	FromBlock uint64
+	ToBlock   uint64
+	Length    uint64
+}
+
+// Entry to or exit from the validator set
+type SetChange struct {
+	BlockID     uint64
+	CreatedAt   time.Time
+	Entered     bool
+	PublicKey   string
+	Name        *string
+	Description *string
+	IconUrl     *string
+	SiteUrl     *string
+}
+
+// Get paginated list of blocks of validator from the latest
+func (repository Repository) GetPaginatedByValidator(publicKey string, filter blocks.RangeSelectFilter, pagination *tools.Pagination) ([]models.BlockValidator, error) {
+	var blockValidators []models.BlockValidator
+	var err error
+
+	pagination.Total, err = repository.db.Model(&blockValidators).
+		Join("INNER JOIN validators AS v ON v.id = block_validator.validator_id").
+		Where("v.public_key = ?", publicKey).
+		Apply(filter.Filter).
+		Order("block_id DESC").
+		Apply(pagination.Filter).
+		SelectAndCount()
+
+	return blockValidators, err
+}
+
+// Get counts of signed and missed blocks of validator by periods of the scale
+func (repository Repository) GetChartData(publicKey string, scale string, startTime time.Time, endTime *time.Time) ([]ChartData, error) {
+	var data []ChartData
+
+	_, err := repository.db.Query(&data, `
+		SELECT date_trunc(?1, bv.created_at) AS time,
+			COUNT(*) FILTER (WHERE bv.signed) AS signed,
+			COUNT(*) FILTER (WHERE NOT bv.signed) AS missed
+		FROM block_validator AS bv
+		WHERE bv.validator_id = (SELECT id FROM validators WHERE public_key = ?0)
+			AND bv.created_at >= ?2 AND (?3::timestamptz IS NULL OR bv.created_at <= ?3)
+		GROUP BY time
+		ORDER BY time`,
+		publicKey, scale, startTime, endTime)
+
+	return data, err
+}
+
+// Get the longest series of consecutive missed blocks of validator in period,
+// blocks out of the validator set do not break the series
+func (repository Repository) GetLongestMissedStreak(publicKey string, startTime time.Time, endTime *time.Time) (*Streak, error) {
+	var streaks []Streak
+
+	_, err := repository.db.Query(&streaks, `
+		SELECT MIN(s.block_id) AS from_block, MAX(s.block_id) AS to_block, COUNT(*) AS length
+		FROM (
+			SELECT bv.block_id, bv.signed,
+				ROW_NUMBER() OVER (ORDER BY bv.block_id) - ROW_NUMBER() OVER (PARTITION BY bv.signed ORDER BY bv.block_id) AS grp
+			FROM block_validator AS bv
+			WHERE bv.validator_id = (SELECT id FROM validators WHERE public_key = ?0)
+				AND bv.created_at >= ?1 AND (?2::timestamptz IS NULL OR bv.created_at <= ?2)
+		) AS s
+		WHERE NOT s.signed
+		GROUP BY s.grp
+		ORDER BY length DESC, from_block DESC
+		LIMIT 1`,
+		publicKey, startTime, endTime)
+	if err != nil || len(streaks) == 0 {
+		return nil, err
+	}
+
+	return &streaks[0], nil
+}
+
+// Get count of blocks missed by validator since the last signed block
+func (repository Repository) GetCurrentMissedStreak(publicKey string) (uint64, error) {
+	var count uint64
+
+	_, err := repository.db.QueryOne(pg.Scan(&count), `
+		WITH validator AS (SELECT id FROM validators WHERE public_key = ?0)
+		SELECT COUNT(*) FROM block_validator AS bv
+		WHERE bv.validator_id = (SELECT id FROM validator) AND NOT bv.signed
+			AND bv.block_id > COALESCE((
+				SELECT MAX(block_id) FROM block_validator
+				WHERE validator_id = (SELECT id FROM validator) AND signed
+			), 0)`,
+		publicKey)
+
+	return count, err
+}
+
+// Get entries to and exits from the validator set at blocks in range [startBlock, endBlock] from the latest,
+// the validator enters the set at the first block of consecutive blocks with it and exits after the last one
+func (repository Repository) GetSetChanges(startBlock uint64, endBlock uint64) ([]SetChange, error) {
+	var changes []SetChange
+
+	_, err := repository.db.Query(&changes, `
+		WITH ranged AS (
+			SELECT validator_id, block_id,
+				LAG(block_id) OVER w AS prev_block_id, LEAD(block_id) OVER w AS next_block_id
+			FROM block_validator
+			WHERE block_id >= ?0 - 1 AND block_id <= ?1 + 1
+			WINDOW w AS (PARTITION BY validator_id ORDER BY block_id)
+		), changes AS (
+			SELECT block_id, validator_id, TRUE AS entered FROM ranged
+			WHERE block_id >= ?0 AND block_id <= ?1 AND prev_block_id IS DISTINCT FROM block_id - 1
+			UNION ALL
+			SELECT block_id + 1, validator_id, FALSE FROM ranged
+			WHERE block_id + 1 >= ?0 AND block_id + 1 <= ?1 AND next_block_id IS DISTINCT FROM block_id + 1
+		)
+		SELECT c.block_id, b.created_at, c.entered,
+			v.public_key, v.name, v.description, v.icon_url, v.site_url
+		FROM changes AS c
+		INNER JOIN blocks AS b ON b.id = c.block_id
+		INNER JOIN validators AS v ON v.id = c.validator_id
+		ORDER BY c.block_id DESC, c.entered, v.public_key`,
+		startBlock, endBlock)
+
+	return changes, err
+}
@@ -0,0 +1,113 @@
+package block_validator
+
+import (
+	"time"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+	"github.com/noah-blockchain/noah-explorer-api/internal/validator/meta"
+)
+
+type Resource struct {
+	Block     uint64 `json:"block"`
+	Signed    bool   `json:"signed"`
+	Timestamp string `json:"timestamp"`
+}
+
+func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	blockValidator := model.(models.BlockValidator)
+
+	return Resource{
+		Block:     blockValidator.BlockID,
+		Signed:    blockValidator.Signed,
+		Timestamp: blockValidator.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+type ChartResource struct {
+	Time   string `json:"time"`
+	Signed uint64 `json:"signed"`
+	Missed uint64 `json:"missed"`
+}
+
+func (ChartResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	data := model.(ChartData)
+
+	return ChartResource{
+		Time:   data.Time.Format(time.RFC3339),
+		Signed: data.Signed,
+		Missed: data.Missed,
+	}
+}
+
+type StreakResource struct {
+	FromBlock uint64 `json:"from_block"`
+	ToBlock   uint64 `json:"to_block"`
+	Length    uint64 `json:"length"`
+}
+
+type StatisticsResource struct {
+	Signed              uint64               `json:"signed"`
+	Missed              uint64               `json:"missed"`
+	LongestMissedStreak *StreakResource      `json:"longest_missed_streak"`
+	CurrentMissedStreak uint64               `json:"current_missed_streak"`
+	Chart               []resource.Interface `json:"chart"`
+}
+
+type Statistics struct {
+	Chart               []ChartData
+	LongestMissedStreak *Streak
+	CurrentMissedStreak uint64
+}
+
+func (StatisticsResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	statistics := model.(Statistics)
+
+	res := StatisticsResource{
+		CurrentMissedStreak: statistics.CurrentMissedStreak,
+		Chart:               resource.TransformCollection(statistics.Chart, ChartResource{}),
+	}
+
+	for _, data := range statistics.Chart {
+		res.Signed += data.Signed
+		res.Missed += data.Missed
+	}
+
+	if streak := statistics.LongestMissedStreak; streak != nil {
+		res.LongestMissedStreak = &StreakResource{streak.FromBlock, streak.ToBlock, streak.Length}
+	}
+
+	return res
+}
+
+type SetChangeResource struct {
+	Block         uint64             `json:"block"`
+	Timestamp     string             `json:"timestamp"`
+	Type          string             `json:"type"`
+	PublicKey     string             `json:"public_key"`
+	ValidatorMeta resource.Interface `json:"validator_meta"`
+}
+
+func (SetChangeResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	change := model.(SetChange)
+	validator := models.Validator{
+		PublicKey:   change.PublicKey,
+		Name:        change.Name,
+		Description: change.Description,
+		IconUrl:     change.IconUrl,
+		SiteUrl:     change.SiteUrl,
+	}
+
+	changeType := "exit"
+	if change.Entered {
+		changeType = "entry"
+	}
+
+	return SetChangeResource{
+		Block:         change.BlockID,
+		Timestamp:     change.CreatedAt.Format(time.RFC3339),
+		Type:          changeType,
+		PublicKey:     validator.GetPublicKey(),
+		ValidatorMeta: new(meta.Resource).Transform(validator),
+	}
+}
@@ -21,7 +21,7 @@ func NewRepository(db *pg.DB) *Repository {
 }
 
 // Get block by height (id)
-func (repository Repository) GetById(id uint64) *models.Block {
+func (repository Repository) GetById(id uint64) (*models.Block, error) {
 	var block models.Block
 
 	err := repository.DB.Model(&block).
@@ -30,40 +30,65 @@ func (repository Repository) GetById(id uint64) *models.Block {
 		Select()
 
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	return &block
+	return &block, nil
 }
 
 // Get paginated list of blocks
-func (repository Repository) GetPaginated(pagination *tools.Pagination) []models.Block {
+func (repository Repository) GetPaginated(pagination *tools.Pagination) ([]models.Block, error) {
 	var blocks []models.Block
 	var err error
 
-	pagination.Total, err = repository.DB.Model(&blocks).
+	query := repository.DB.Model(&blocks).
 		Column("BlockValidators", "BlockValidators.Validator").
-		Apply(pagination.Filter).
-		Order("id DESC").
-		SelectAndCount()
+		Order("id DESC")
 
-	helpers.CheckErr(err)
+	if pagination.Keyset {
+		err = query.Apply(pagination.KeysetFilter("block.id")).Select()
+		if err != nil {
+			return nil, err
+		}
 
-	return blocks
+		if len(blocks) > pagination.GetPerPage() {
+			blocks = blocks[:pagination.GetPerPage()]
+			pagination.SetNextCursor(blocks[len(blocks)-1].ID)
+		}
+
+		return blocks, nil
+	}
+
+	pagination.Total, err = query.Apply(pagination.Filter).SelectAndCount()
+
+	return blocks, err
+}
+
+// Get list of blocks with height in range (fromId, toId] ordered by height
+func (repository Repository) GetAfterId(fromId uint64, toId uint64) ([]models.Block, error) {
+	var blocks []models.Block
+
+	err := repository.DB.Model(&blocks).
+		Column("BlockValidators", "BlockValidators.Validator").
+		Where("block.id > ?", fromId).
+		Where("block.id <= ?", toId).
+		Order("block.id ASC").
+		Select()
+
+	return blocks, err
 }
 
 // Get last block
-func (repository Repository) GetLastBlock() models.Block {
+func (repository Repository) GetLastBlock() (models.Block, error) {
 	var block models.Block
 
 	err := repository.DB.Model(&block).Last()
-	helpers.CheckErr(err)
 
-	return block
+	return block, err
 }
 
 // Get average block time
-func (repository Repository) GetAverageBlockTime() float64 {
+func (repository Repository) GetAverageBlockTime() (float64, error) {
 	var block models.Block
 	var blockTime float64
 
@@ -72,13 +97,11 @@ func (repository Repository) GetAverageBlockTime() float64 {
 		Where("created_at >= ?", time.Now().AddDate(0, 0, -1).Format(time.RFC3339)).
 		Select(&blockTime)
 
-	helpers.CheckErr(err)
-
-	return blockTime
+	return blockTime, err
 }
 
 // Get sum of delta slow time
-func (repository Repository) GetSumSlowBlocksTimeBy24h() float64 {
+func (repository Repository) GetSumSlowBlocksTimeBy24h() (float64, error) {
 	var block models.Block
 	var sum float64
 
@@ -88,7 +111,5 @@ func (repository Repository) GetSumSlowBlocksTimeBy24h() float64 {
 		Where("created_at >= ?", time.Now().AddDate(0, 0, -1).Format(time.RFC3339)).
 		Select(&sum)
 
-	helpers.CheckErr(err)
-
-	return sum
+	return sum, err
 }
@@ -0,0 +1,122 @@
+package candle
+
+import (
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+)
+
+type Repository struct {
+	db             *pg.DB
+	baseCoinSymbol string
+}
+
+func NewRepository(db *pg.DB, baseCoinSymbol string) *Repository {
+	return &Repository{
+		db:             db,
+		baseCoinSymbol: baseCoinSymbol,
+	}
+}
+
+// Minute candle of coin trades against the base coin,
+// prices are in the base coin and volume is in qNoah of the base coin
+type Candle struct {
+	tableName struct{}  `sql:"coin_candles"`
+	Coin      string    `sql:"coin,pk"`
+	Time      time.Time `sql:"time,pk"`
+	Open      string    `sql:"open,notnull,type:numeric"`
+	High      string    `sql:"high,notnull,type:numeric"`
+	Low       string    `sql:"low,notnull,type:numeric"`
+	Close     string    `sql:"close,notnull,type:numeric"`
+	Volume    string    `sql:"volume,notnull,type:numeric(70)"`
+}
+
+// Trading summary of coin for a period
+type Ticker struct {
+	Coin   string
+	Open   string
+	Close  string
+	Volume string
+}
+
+// Create table of minute candles aggregated by the api from conversions to and from the base coin
+func (repository Repository) Migrate() error {
+	return repository.db.CreateTable((*Candle)(nil), &orm.CreateTableOptions{IfNotExists: true})
+}
+
+// Get time of the last collected candle
+func (repository Repository) GetLastTime() (*time.Time, error) {
+	var last pg.NullTime
+	_, err := repository.db.QueryOne(pg.Scan(&last), `SELECT MAX(time) FROM coin_candles`)
+	if err != nil || last.IsZero() {
+		return nil, err
+	}
+
+	return &last.Time, nil
+}
+
+// Collect minute candles from buy, sell and sell all transactions since the time, all history by default.
+// Only conversions between a coin and the base coin have the executed price,
+// amounts of coin to coin conversions through the base coin are unknown.
+func (repository Repository) Collect(since *time.Time) error {
+	_, err := repository.db.Exec(`
+		INSERT INTO coin_candles (coin, time, open, high, low, close, volume)
+		SELECT tr.coin, date_trunc('minute', tr.created_at) AS time,
+			(array_agg(tr.price ORDER BY tr.id))[1], MAX(tr.price), MIN(tr.price),
+			(array_agg(tr.price ORDER BY tr.id DESC))[1], SUM(tr.noah)
+		FROM (
+			SELECT t.id, t.created_at,
+				CASE WHEN t.data->>'coin_to_sell' = ?0 THEN t.data->>'coin_to_buy' ELSE t.data->>'coin_to_sell' END AS coin,
+				CASE WHEN t.data->>'coin_to_sell' = ?0 THEN v.sold ELSE v.bought END AS noah,
+				ROUND(CASE WHEN t.data->>'coin_to_sell' = ?0 THEN v.sold / v.bought ELSE v.bought / v.sold END, 18) AS price
+			FROM transactions AS t,
+			LATERAL (
+				SELECT (CASE t.type WHEN ?2 THEN t.data->>'value_to_sell' WHEN ?3 THEN t.tags->>'tx.sell_amount' ELSE t.tags->>'tx.return' END)::numeric AS sold,
+					(CASE t.type WHEN ?4 THEN t.data->>'value_to_buy' ELSE t.tags->>'tx.return' END)::numeric AS bought
+			) AS v
+			WHERE t.type IN (?2, ?3, ?4)
+				AND (?1::timestamptz IS NULL OR t.created_at >= date_trunc('minute', ?1::timestamptz))
+				AND (t.data->>'coin_to_sell' = ?0) <> (t.data->>'coin_to_buy' = ?0)
+				AND v.sold > 0 AND v.bought > 0
+		) AS tr
+		GROUP BY tr.coin, time
+		ON CONFLICT (coin, time) DO UPDATE SET open = EXCLUDED.open, high = EXCLUDED.high, low = EXCLUDED.low,
+			close = EXCLUDED.close, volume = EXCLUDED.volume`,
+		repository.baseCoinSymbol, since, models.TxTypeSellCoin, models.TxTypeSellAllCoin, models.TxTypeBuyCoin)
+
+	return err
+}
+
+// Get candles of coin by scale in period
+func (repository Repository) GetCandles(symbol string, scale string, startTime time.Time, endTime *time.Time) ([]Candle, error) {
+	var candles []Candle
+
+	_, err := repository.db.Query(&candles, `
+		SELECT date_trunc(?1, c.time) AS time,
+			(array_agg(c.open ORDER BY c.time))[1] AS open, MAX(c.high) AS high, MIN(c.low) AS low,
+			(array_agg(c.close ORDER BY c.time DESC))[1] AS close, SUM(c.volume) AS volume
+		FROM coin_candles AS c
+		WHERE c.coin = ?0 AND c.time >= ?2 AND (?3::timestamptz IS NULL OR c.time <= ?3)
+		GROUP BY 1
+		ORDER BY 1`,
+		symbol, scale, startTime, endTime)
+
+	return candles, err
+}
+
+// Get tickers of traded coins since the time
+func (repository Repository) GetTickers(since time.Time) ([]Ticker, error) {
+	var tickers []Ticker
+
+	_, err := repository.db.Query(&tickers, `
+		SELECT c.coin, SUM(c.volume) AS volume,
+			(array_agg(c.open ORDER BY c.time))[1] AS open, (array_agg(c.close ORDER BY c.time DESC))[1] AS close
+		FROM coin_candles AS c
+		WHERE c.time >= ?0
+		GROUP BY c.coin`,
+		since)
+
+	return tickers, err
+}
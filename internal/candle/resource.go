@@ -0,0 +1,52 @@
+package candle
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+)
+
+const precision = 100
+
+type Resource struct {
+	Time   string `json:"time"`
+	Open   string `json:"open"`
+	High   string `json:"high"`
+	Low    string `json:"low"`
+	Close  string `json:"close"`
+	Volume string `json:"volume"`
+}
+
+func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	candle := model.(Candle)
+
+	return Resource{
+		Time:   candle.Time.Format(time.RFC3339),
+		Open:   candle.Open,
+		High:   candle.High,
+		Low:    candle.Low,
+		Close:  candle.Close,
+		Volume: helpers.QNoahStr2Noah(candle.Volume),
+	}
+}
+
+// Percent change of price from the first to the last trade of ticker period
+func (ticker Ticker) PriceChange() *string {
+	open, ok := helpers.NewFloat(0, precision).SetString(ticker.Open)
+	if !ok || open.Sign() <= 0 {
+		return nil
+	}
+
+	change, ok := helpers.NewFloat(0, precision).SetString(ticker.Close)
+	if !ok {
+		return nil
+	}
+
+	change.Sub(change, open)
+	change.Quo(change, open)
+	percent := change.Mul(change, big.NewFloat(100)).Text('f', 2)
+
+	return &percent
+}
@@ -0,0 +1,14 @@
+package candle
+
+import "testing"
+
+func TestTickerPriceChange(t *testing.T) {
+	change := Ticker{Open: "2.000000000000000000", Close: "2.5"}.PriceChange()
+	if change == nil || *change != "25.00" {
+		t.Fatalf("expected change 25.00, got %v", change)
+	}
+
+	if (Ticker{Open: "0", Close: "1"}).PriceChange() != nil {
+		t.Fatalf("expected no change without open price")
+	}
+}
@@ -0,0 +1,25 @@
+package chart
+
+import (
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/balance"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+)
+
+type BalanceResource struct {
+	Time               string `json:"time"`
+	Amount             string `json:"amount"`
+	CommissionExcluded bool   `json:"commission_excluded,omitempty"`
+}
+
+func (BalanceResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	data := model.(balance.Point)
+
+	return BalanceResource{
+		Time:               data.Time.Format(time.RFC3339),
+		Amount:             helpers.QNoahStr2Noah(data.Value),
+		CommissionExcluded: data.CommissionExcluded,
+	}
+}
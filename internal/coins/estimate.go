@@ -0,0 +1,136 @@
+package coins
+
+import (
+	"math/big"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-go-node/core/commissions"
+	"github.com/noah-blockchain/noah-go-node/formula"
+)
+
+const precision = 100
+
+// Commission of convert transaction in qNoah with the default gas price
+var convertCommission = new(big.Int).Mul(big.NewInt(commissions.ConvertTx), big.NewInt(1000000000000000))
+
+// Expected result of conversion, values are in qNoah
+type Estimate struct {
+	ValueToSell *big.Int
+	ValueToBuy  *big.Int
+	Commission  *big.Int
+	Price       *big.Float
+	PriceImpact *big.Float
+}
+
+// Conversion between coins through the base coin, nil curve stands for the base coin
+type Conversion struct {
+	Sell *Curve
+	Buy  *Curve
+}
+
+// Estimate conversion of the exact value of coin to sell
+func (c Conversion) EstimateSell(value *big.Int) (*Estimate, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	base := value
+	if c.Sell != nil {
+		if value.Cmp(c.Sell.Volume) > 0 {
+			return nil, errors.NewInvalidInput("Amount exceeds the coin volume.", nil)
+		}
+
+		base = formula.CalculateSaleReturn(c.Sell.Volume, c.Sell.Reserve, c.Sell.Crr, value)
+	}
+
+	result := base
+	if c.Buy != nil {
+		result = formula.CalculatePurchaseReturn(c.Buy.Volume, c.Buy.Reserve, c.Buy.Crr, base)
+	}
+
+	return c.estimate(value, result)
+}
+
+// Estimate conversion to the exact value of coin to buy
+func (c Conversion) EstimateBuy(value *big.Int) (*Estimate, error) {
+	if err := c.validate(); err != nil {
+		return nil, err
+	}
+
+	base := value
+	if c.Buy != nil {
+		base = formula.CalculatePurchaseAmount(c.Buy.Volume, c.Buy.Reserve, c.Buy.Crr, value)
+	}
+
+	result := base
+	if c.Sell != nil {
+		if base.Cmp(c.Sell.Reserve) >= 0 {
+			return nil, errors.NewInvalidInput("Amount exceeds the coin reserve.", nil)
+		}
+
+		result = formula.CalculateSaleAmount(c.Sell.Volume, c.Sell.Reserve, c.Sell.Crr, base)
+	}
+
+	return c.estimate(result, value)
+}
+
+func (c Conversion) estimate(valueToSell *big.Int, valueToBuy *big.Int) (*Estimate, error) {
+	if valueToBuy.Sign() <= 0 {
+		return nil, errors.NewInvalidInput("Amount is too small to convert.", nil)
+	}
+
+	commission := convertCommission
+	if c.Sell != nil {
+		if commission.Cmp(c.Sell.Reserve) >= 0 {
+			return nil, errors.NewInvalidInput("Coin reserve is not enough to pay the commission.", nil)
+		}
+
+		commission = formula.CalculateSaleAmount(c.Sell.Volume, c.Sell.Reserve, c.Sell.Crr, convertCommission)
+	}
+
+	price := helpers.NewFloat(0, precision).SetInt(valueToSell)
+	price.Quo(price, helpers.NewFloat(0, precision).SetInt(valueToBuy))
+
+	// spot price of coin to buy in coin to sell before the conversion
+	spot := c.Buy.price()
+	spot.Quo(spot, c.Sell.price())
+
+	impact := helpers.NewFloat(0, precision).Quo(price, spot)
+	impact.Sub(impact, big.NewFloat(1))
+	impact.Mul(impact, big.NewFloat(100))
+
+	return &Estimate{
+		ValueToSell: valueToSell,
+		ValueToBuy:  valueToBuy,
+		Commission:  commission,
+		Price:       price,
+		PriceImpact: impact,
+	}, nil
+}
+
+func (c Conversion) validate() error {
+	if c.Sell == nil && c.Buy == nil {
+		return errors.NewInvalidInput("Coins to sell and to buy must differ.", nil)
+	}
+
+	for _, curve := range []*Curve{c.Sell, c.Buy} {
+		if curve != nil && (curve.Volume.Sign() <= 0 || curve.Reserve.Sign() <= 0 || curve.Crr == 0) {
+			return errors.NewInvalidInput("Coin has no reserve to convert.", nil)
+		}
+	}
+
+	return nil
+}
+
+// Marginal price of coin in the base coin: reserve / (volume * crr)
+func (curve *Curve) price() *big.Float {
+	if curve == nil {
+		return helpers.NewFloat(1, precision)
+	}
+
+	price := helpers.NewFloat(0, precision).SetInt(curve.Reserve)
+	price.Mul(price, big.NewFloat(100))
+
+	return price.Quo(price, helpers.NewFloat(0, precision).SetInt(new(big.Int).Mul(curve.Volume, big.NewInt(int64(curve.Crr)))))
+}
@@ -0,0 +1,47 @@
+package coins
+
+import (
+	"math/big"
+	"testing"
+)
+
+// amount of noahs in qNoah
+func noahs(value int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(value), big.NewInt(1000000000000000000))
+}
+
+func TestEstimateSell(t *testing.T) {
+	// with crr 100 one coin always costs reserve / volume of base coin
+	curve := &Curve{Volume: noahs(1000), Reserve: noahs(2000), Crr: 100}
+
+	estimate, err := Conversion{Sell: curve}.EstimateSell(noahs(100))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if estimate.ValueToBuy.String() != noahs(200).String() || estimate.PriceImpact.Text('f', 2) != "0.00" {
+		t.Fatalf("unexpected value %s or impact %s", estimate.ValueToBuy, estimate.PriceImpact.Text('f', 2))
+	}
+
+	if _, err := (Conversion{Sell: curve}).EstimateSell(noahs(1001)); err == nil {
+		t.Fatalf("expected error for amount above the volume")
+	}
+}
+
+func TestEstimateBuy(t *testing.T) {
+	sell := &Curve{Volume: noahs(1000), Reserve: noahs(2000), Crr: 100}
+	buy := &Curve{Volume: noahs(1000), Reserve: noahs(4000), Crr: 100}
+
+	estimate, err := Conversion{Sell: sell, Buy: buy}.EstimateBuy(noahs(10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if estimate.ValueToSell.String() != noahs(20).String() || estimate.Price.Text('f', 0) != "2" {
+		t.Fatalf("unexpected value %s or price %s", estimate.ValueToSell, estimate.Price.Text('f', 2))
+	}
+
+	if _, err := (Conversion{}).EstimateBuy(noahs(10)); err == nil {
+		t.Fatalf("expected error for conversion of the base coin to itself")
+	}
+}
@@ -0,0 +1,40 @@
+package coins
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-go-node/formula"
+)
+
+// Reserve parameters of coin in qNoah
+type Curve struct {
+	Volume  *big.Int
+	Reserve *big.Int
+	Crr     uint
+}
+
+func NewCurve(coin models.Coin) (*Curve, error) {
+	volume, ok := new(big.Int).SetString(coin.Volume, 10)
+	if !ok {
+		return nil, errors.NewMalformedData(fmt.Sprintf("Invalid volume of coin %s", coin.Symbol), nil)
+	}
+
+	reserve, ok := new(big.Int).SetString(coin.ReserveBalance, 10)
+	if !ok {
+		return nil, errors.NewMalformedData(fmt.Sprintf("Invalid reserve balance of coin %s", coin.Symbol), nil)
+	}
+
+	return &Curve{Volume: volume, Reserve: reserve, Crr: uint(coin.Crr)}, nil
+}
+
+// Base coin value of coin amount, the same as the node calculates noah value of stake
+func (curve Curve) NoahValue(amount *big.Int) (*big.Int, error) {
+	if amount.Cmp(curve.Volume) > 0 {
+		return nil, errors.NewInvalidInput("Amount exceeds the coin volume.", nil)
+	}
+
+	return formula.CalculateSaleReturn(curve.Volume, curve.Reserve, curve.Crr, amount), nil
+}
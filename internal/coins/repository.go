@@ -2,6 +2,7 @@ package coins
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/go-pg/pg"
 	"github.com/noah-blockchain/coinExplorer-tools/models"
@@ -40,7 +41,7 @@ func (repository *Repository) GetCustomCoinsStatusData() (CustomCoinsStatusData,
 }
 
 // Get paginated list of blocks
-func (repository Repository) GetPaginated(pagination *tools.Pagination, field *string, orderBy *string, symbol *string) []models.Coin {
+func (repository Repository) GetPaginated(pagination *tools.Pagination, field *string, orderBy *string, symbol *string) ([]models.Coin, error) {
 	var coins []models.Coin
 	var err error
 	fieldSql := "id"
@@ -70,13 +71,12 @@ func (repository Repository) GetPaginated(pagination *tools.Pagination, field *s
 		Order(fmt.Sprintf("coin.%s %s", fieldSql, orderBySql))
 
 	pagination.Total, err = query.SelectAndCount()
-	helpers.CheckErr(err)
 
-	return coins
+	return coins, err
 }
 
 // Get coin by symbol
-func (repository Repository) GetBySymbol(symbol string) *models.Coin {
+func (repository Repository) GetBySymbol(symbol string) (*models.Coin, error) {
 	var coin models.Coin
 
 	err := repository.DB.Model(&coin).
@@ -89,8 +89,38 @@ func (repository Repository) GetBySymbol(symbol string) *models.Coin {
 		Select()
 
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	return &coin
+	return &coin, nil
+}
+
+// Get coins with prices by symbols
+func (repository Repository) GetBySymbols(symbols []string) ([]models.Coin, error) {
+	var coins []models.Coin
+	if len(symbols) == 0 {
+		return coins, nil
+	}
+
+	err := repository.DB.Model(&coins).
+		Column("coin.id", "coin.symbol", "coin.price").
+		Where("coin.symbol IN (?)", pg.In(symbols)).
+		Select()
+
+	return coins, err
+}
+
+// Get coins with symbol starting with prefix, the shortest symbols first
+func (repository Repository) GetBySymbolPrefix(prefix string, limit int) ([]models.Coin, error) {
+	var coins []models.Coin
+
+	err := repository.DB.Model(&coins).
+		Column("coin.name", "coin.symbol", "coin.icon_url").
+		Where("coin.symbol LIKE ?", helpers.EscapeLike(strings.ToUpper(prefix))+"%").
+		Where("coin.deleted_at IS NULL").
+		OrderExpr("length(coin.symbol) ASC, coin.symbol ASC").
+		Limit(limit).
+		Select()
+
+	return coins, err
 }
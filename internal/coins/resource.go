@@ -4,26 +4,37 @@ import (
 	"time"
 
 	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/candle"
 	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/market"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
 )
 
 type Resource struct {
-	Crr                 uint64 `json:"crr"`
-	Volume              string `json:"volume"`
-	ReserveBalance      string `json:"reserve_balance"`
-	Name                string `json:"name"`
-	Symbol              string `json:"symbol"`
-	Price               string `json:"price"`
-	StartPrice          string `json:"start_price"`
-	StartVolume         string `json:"start_volume"`
-	StartReserveBalance string `json:"start_reserve_balance"`
-	Capitalization      string `json:"capitalization"`
-	Delegated           uint64 `json:"delegated"`
-	CreatedAt           string `json:"created_at"`
-	Creator             string `json:"creator"`
-	Description         string `json:"description"`
-	IconURL             string `json:"icon_url"`
+	Crr                 uint64  `json:"crr"`
+	Volume              string  `json:"volume"`
+	ReserveBalance      string  `json:"reserve_balance"`
+	Name                string  `json:"name"`
+	Symbol              string  `json:"symbol"`
+	Price               string  `json:"price"`
+	StartPrice          string  `json:"start_price"`
+	StartVolume         string  `json:"start_volume"`
+	StartReserveBalance string  `json:"start_reserve_balance"`
+	Capitalization      string  `json:"capitalization"`
+	Delegated           uint64  `json:"delegated"`
+	CreatedAt           string  `json:"created_at"`
+	Creator             string  `json:"creator"`
+	Description         string  `json:"description"`
+	IconURL             string  `json:"icon_url"`
+	Volume24h           string  `json:"volume_24h"`
+	PriceChange24h      *string `json:"price_change_24h"`
+	FiatPrice           *string `json:"fiat_price"`
+}
+
+// Trading summary of the last 24 hours and fiat rates of coin
+type Params struct {
+	Ticker candle.Ticker
+	Rates  market.Rates
 }
 
 func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
@@ -45,7 +56,45 @@ func (Resource) Transform(model resource.ItemInterface, params ...resource.Param
 		Creator:             coin.GetAddress(),
 		Description:         coin.Description,
 		IconURL:             coin.IconURL,
+		Volume24h:           "0",
+	}
+
+	if len(params) > 0 {
+		p := params[0].(Params)
+		res.Volume24h = helpers.QNoahStr2Noah(p.Ticker.Volume)
+		res.PriceChange24h = p.Ticker.PriceChange()
+		res.FiatPrice = p.Rates.CoinPrice(&coin)
 	}
 
 	return res
 }
+
+type EstimateResource struct {
+	CoinToSell  string `json:"coin_to_sell"`
+	CoinToBuy   string `json:"coin_to_buy"`
+	ValueToSell string `json:"value_to_sell"`
+	ValueToBuy  string `json:"value_to_buy"`
+	Commission  string `json:"commission"`
+	Price       string `json:"price"`
+	PriceImpact string `json:"price_impact"`
+}
+
+func (EstimateResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	estimate := model.(Estimate)
+	conversion := params[0].(EstimateParams)
+
+	return EstimateResource{
+		CoinToSell:  conversion.CoinToSell,
+		CoinToBuy:   conversion.CoinToBuy,
+		ValueToSell: helpers.QNoahStr2Noah(estimate.ValueToSell.String()),
+		ValueToBuy:  helpers.QNoahStr2Noah(estimate.ValueToBuy.String()),
+		Commission:  helpers.QNoahStr2Noah(estimate.Commission.String()),
+		Price:       estimate.Price.Text('f', 18),
+		PriceImpact: estimate.PriceImpact.Text('f', 2),
+	}
+}
+
+type EstimateParams struct {
+	CoinToSell string
+	CoinToBuy  string
+}
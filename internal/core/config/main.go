@@ -6,3 +6,24 @@ const NetworkActivePeriod = 90
 const SlowBlocksMaxTimeInSec = 6
 const MaxPaginationOffset = 5000000
 const MarketPriceUpdatePeriodInMin = 2
+const StreamPollPeriodInMs = 1000
+const StreamMaxBlocksPerPoll = 50
+const StreamSubscriberBufferSize = 256
+const StreamHeartbeatPeriodInSec = 15
+const ChainMetricsCollectPeriodInSec = 15
+const AnonymousRateLimit = 5
+const AnonymousRateBurst = 5
+const ApiKeysReloadPeriodInSec = 10
+const RateLimitEvictionPeriodInSec = 60
+const RateLimitIdleTimeoutInSec = 600
+const SearchSuggestionsLimit = 10
+const UnbondPeriodInBlocks = 518400
+const ValidatorApyPeriodInDays = 30
+const ValidatorSetChangesDefaultBlocks = 17280
+const ValidatorSetChangesMaxBlocks = 100000
+const DecentralizationTopCount = 10
+const DecentralizationSnapshotPeriodInSec = 3600
+const CoinCandlesCollectPeriodInSec = 60
+const CoinHoldersSnapshotPeriodInSec = 3600
+const RichListRefreshPeriodInSec = 600
+const CacheExpirationCheckPeriodInSec = 60
@@ -3,31 +3,56 @@ package core
 import (
 	"os"
 	"strconv"
+	"time"
 )
 
 type Environment struct {
-	DbName     string
-	DbUser     string
-	DbPassword string
-	DbPoolSize int
-	DbHost     string
-	DbPort     int
-	BaseCoin   string
-	ServerPort int
-	IsDebug    bool
+	DbName                string
+	DbUser                string
+	DbPassword            string
+	DbPoolSize            int
+	DbHost                string
+	DbPort                int
+	BaseCoin              string
+	ServerPort            int
+	IsDebug               bool
+	HttpReadTimeout       time.Duration
+	HttpReadHeaderTimeout time.Duration
+	HttpWriteTimeout      time.Duration // zero by default, stream responses are long-lived
+	HttpIdleTimeout       time.Duration
+	HttpMaxHeaderBytes    int
+	HttpShutdownTimeout   time.Duration
+	ApiKeysFile           string // JSON file with API keys and their tiers, reloaded on change
+	MarketPriceFeed       string // http or file, market prices are disabled by default
+	MarketPriceUrl        string
+	MarketPriceCoinId     string
+	MarketPriceFile       string
+	MarketCurrency        string
 }
 
 func NewEnvironment() *Environment {
 	env := Environment{
-		DbName:     os.Getenv("DB_NAME"),
-		DbUser:     os.Getenv("DB_USER"),
-		DbPassword: os.Getenv("DB_PASSWORD"),
-		DbPoolSize: getEnvAsInt("DB_POOL_SIZE", 10),
-		DbHost:     os.Getenv("DB_HOST"),
-		DbPort:     getEnvAsInt("DB_PORT", 5432),
-		BaseCoin:   getEnv("BASE_COIN", "NOAH"),
-		ServerPort: getEnvAsInt("COIN_EXPLORER_API_PORT", 9070),
-		IsDebug:    getEnvAsBool("DEBUG", true),
+		DbName:                os.Getenv("DB_NAME"),
+		DbUser:                os.Getenv("DB_USER"),
+		DbPassword:            os.Getenv("DB_PASSWORD"),
+		DbPoolSize:            getEnvAsInt("DB_POOL_SIZE", 10),
+		DbHost:                os.Getenv("DB_HOST"),
+		DbPort:                getEnvAsInt("DB_PORT", 5432),
+		BaseCoin:              getEnv("BASE_COIN", "NOAH"),
+		ServerPort:            getEnvAsInt("COIN_EXPLORER_API_PORT", 9070),
+		IsDebug:               getEnvAsBool("DEBUG", true),
+		HttpReadTimeout:       getEnvAsSeconds("HTTP_READ_TIMEOUT", 15),
+		HttpReadHeaderTimeout: getEnvAsSeconds("HTTP_READ_HEADER_TIMEOUT", 5),
+		HttpWriteTimeout:      getEnvAsSeconds("HTTP_WRITE_TIMEOUT", 0),
+		HttpIdleTimeout:       getEnvAsSeconds("HTTP_IDLE_TIMEOUT", 60),
+		HttpMaxHeaderBytes:    getEnvAsInt("HTTP_MAX_HEADER_BYTES", 1<<16),
+		HttpShutdownTimeout:   getEnvAsSeconds("HTTP_SHUTDOWN_TIMEOUT", 30),
+		ApiKeysFile:           os.Getenv("API_KEYS_FILE"),
+		MarketPriceFeed:       os.Getenv("MARKET_PRICE_FEED"),
+		MarketPriceUrl:        getEnv("MARKET_PRICE_URL", "https://api.coingecko.com/api/v3"),
+		MarketPriceCoinId:     getEnv("MARKET_PRICE_COIN_ID", "noah"),
+		MarketPriceFile:       os.Getenv("MARKET_PRICE_FILE"),
+		MarketCurrency:        getEnv("MARKET_CURRENCY", "USD"),
 	}
 
 	return &env
@@ -50,6 +75,10 @@ func getEnvAsInt(name string, defaultVal int) int {
 	return defaultVal
 }
 
+func getEnvAsSeconds(name string, defaultVal int) time.Duration {
+	return time.Duration(getEnvAsInt(name, defaultVal)) * time.Second
+}
+
 func getEnvAsBool(name string, defaultVal bool) bool {
 	valStr := getEnv(name, "")
 	if val, err := strconv.ParseBool(valStr); err == nil {
@@ -1,17 +1,30 @@
 package core
 
 import (
+	"fmt"
+	"log"
+
 	"github.com/go-pg/pg"
 	"github.com/noah-blockchain/noah-explorer-api/internal/address"
+	addressTimeline "github.com/noah-blockchain/noah-explorer-api/internal/address/timeline"
+	"github.com/noah-blockchain/noah-explorer-api/internal/balance"
+	"github.com/noah-blockchain/noah-explorer-api/internal/block_validator"
 	"github.com/noah-blockchain/noah-explorer-api/internal/blocks"
+	"github.com/noah-blockchain/noah-explorer-api/internal/candle"
 	"github.com/noah-blockchain/noah-explorer-api/internal/coins"
+	"github.com/noah-blockchain/noah-explorer-api/internal/decentralization"
+	"github.com/noah-blockchain/noah-explorer-api/internal/holder"
 	"github.com/noah-blockchain/noah-explorer-api/internal/invalid_transaction"
+	"github.com/noah-blockchain/noah-explorer-api/internal/market"
 	"github.com/noah-blockchain/noah-explorer-api/internal/reward"
+	"github.com/noah-blockchain/noah-explorer-api/internal/rich_list"
 	"github.com/noah-blockchain/noah-explorer-api/internal/slash"
 	"github.com/noah-blockchain/noah-explorer-api/internal/stake"
+	"github.com/noah-blockchain/noah-explorer-api/internal/stream"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools/cache"
 	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
 	"github.com/noah-blockchain/noah-explorer-api/internal/validator"
+	"github.com/noah-blockchain/noah-explorer-api/internal/validator/timeline"
 )
 
 type Explorer struct {
@@ -24,22 +37,82 @@ type Explorer struct {
 	SlashRepository              slash.Repository
 	ValidatorRepository          validator.Repository
 	StakeRepository              stake.Repository
+	BalanceRepository            balance.Repository
+	BlockValidatorRepository     block_validator.Repository
+	ValidatorTimelineRepository  timeline.Repository
+	AddressTimelineRepository    addressTimeline.Repository
+	DecentralizationRepository   decentralization.Repository
+	CandleRepository             candle.Repository
+	HolderRepository             holder.Repository
+	RichListRepository           rich_list.Repository
 	Environment                  Environment
 	Cache                        *cache.ExplorerCache
+	Feed                         *stream.Feed
+	Market                       *market.Service
 }
 
 func NewExplorer(db *pg.DB, env *Environment) *Explorer {
+	blockRepository := *blocks.NewRepository(db)
+	transactionRepository := *transaction.NewRepository(db)
+
 	return &Explorer{
 		CoinRepository:               *coins.NewRepository(db, env.BaseCoin),
-		BlockRepository:              *blocks.NewRepository(db),
+		BlockRepository:              blockRepository,
 		AddressRepository:            *address.NewRepository(db),
-		TransactionRepository:        *transaction.NewRepository(db),
+		TransactionRepository:        transactionRepository,
 		InvalidTransactionRepository: *invalid_transaction.NewRepository(db),
 		RewardRepository:             *reward.NewRepository(db),
 		SlashRepository:              *slash.NewRepository(db),
 		ValidatorRepository:          *validator.NewRepository(db),
 		StakeRepository:              *stake.NewRepository(db),
+		BalanceRepository:            *balance.NewRepository(db, env.BaseCoin),
+		BlockValidatorRepository:     *block_validator.NewRepository(db),
+		ValidatorTimelineRepository:  *timeline.NewRepository(db),
+		AddressTimelineRepository:    *addressTimeline.NewRepository(db),
+		DecentralizationRepository:   *decentralization.NewRepository(db),
+		CandleRepository:             *candle.NewRepository(db, env.BaseCoin),
+		HolderRepository:             *holder.NewRepository(db),
+		RichListRepository:           *rich_list.NewRepository(db, env.BaseCoin),
 		Environment:                  *env,
 		Cache:                        cache.NewCache(),
+		Feed:                         stream.NewFeed(blockRepository, transactionRepository),
+		Market:                       market.NewService(newPriceFeed(env), market.NewRepository(db), env.MarketCurrency, env.BaseCoin),
+	}
+}
+
+// Create feed of market prices by environment, nil if prices are disabled
+func newPriceFeed(env *Environment) market.Feed {
+	switch env.MarketPriceFeed {
+	case "http":
+		return market.NewHttpFeed(env.MarketPriceUrl, env.MarketPriceCoinId)
+	case "file":
+		return market.NewFileFeed(env.MarketPriceFile)
+	case "":
+		return nil
+	}
+
+	log.Printf("unknown market price feed %s, market prices are disabled", env.MarketPriceFeed)
+	return nil
+}
+
+// Create tables and views of data computed by the api, the api must not start without them
+func (explorer *Explorer) Migrate() error {
+	migrations := []struct {
+		name    string
+		migrate func() error
+	}{
+		{"decentralization snapshots", explorer.DecentralizationRepository.Migrate},
+		{"coin candles", explorer.CandleRepository.Migrate},
+		{"coin holder snapshots", explorer.HolderRepository.Migrate},
+		{"market prices", explorer.Market.Migrate},
+		{"rich list", explorer.RichListRepository.Migrate},
 	}
+
+	for _, migration := range migrations {
+		if err := migration.migrate(); err != nil {
+			return fmt.Errorf("failed to create %s: %s", migration.name, err)
+		}
+	}
+
+	return nil
 }
@@ -2,9 +2,27 @@ package database
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/go-pg/pg"
 	"github.com/noah-blockchain/noah-explorer-api/internal/core"
+	"github.com/noah-blockchain/noah-explorer-api/internal/metrics"
+)
+
+var (
+	dbQueryDuration = metrics.NewHistogramVec(
+		"explorer_db_query_duration_seconds",
+		"Duration of database queries by statement type.",
+		metrics.DefaultBuckets,
+		"operation",
+	)
+
+	dbQueryErrorsTotal = metrics.NewCounterVec(
+		"explorer_db_query_errors_total",
+		"Count of failed database queries by statement type.",
+		"operation",
+	)
 )
 
 func Connect(env *core.Environment) *pg.DB {
@@ -21,6 +39,7 @@ func Connect(env *core.Environment) *pg.DB {
 		panic("Could not connect to database")
 	}
 
+	db.AddQueryHook(dbMetrics{})
 	if env.IsDebug {
 		db.AddQueryHook(dbLogger{})
 	}
@@ -42,3 +61,39 @@ func (d dbLogger) BeforeQuery(q *pg.QueryEvent) {}
 func (d dbLogger) AfterQuery(q *pg.QueryEvent) {
 	fmt.Println(q.FormattedQuery())
 }
+
+type dbMetrics struct{}
+
+type queryStartKey struct{}
+
+func (d dbMetrics) BeforeQuery(q *pg.QueryEvent) {
+	q.Data[queryStartKey{}] = time.Now()
+}
+
+func (d dbMetrics) AfterQuery(q *pg.QueryEvent) {
+	start, ok := q.Data[queryStartKey{}].(time.Time)
+	if !ok {
+		return
+	}
+
+	operation := queryOperation(q)
+	dbQueryDuration.Observe(time.Since(start).Seconds(), operation)
+	if q.Error != nil {
+		dbQueryErrorsTotal.Inc(operation)
+	}
+}
+
+// First keyword of the query, e.g. SELECT
+func queryOperation(q *pg.QueryEvent) string {
+	query, err := q.UnformattedQuery()
+	if err != nil {
+		return "unknown"
+	}
+
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return "unknown"
+	}
+
+	return strings.ToUpper(fields[0])
+}
@@ -0,0 +1,86 @@
+package decentralization
+
+import (
+	"math/big"
+	"sort"
+)
+
+const precision = 100
+
+// Stake distribution metrics of holders
+type Metrics struct {
+	Count      int
+	TotalStake *big.Int
+	Nakamoto   int     // minimal count of the largest holders with more than 1/3 of stake
+	Gini       float64 // 0 for equal stakes, close to 1 for one dominating holder
+	TopShare   float64 // percent of stake of the largest holders
+}
+
+// Compute metrics of stakes, top is count of the largest holders for the top share
+func Compute(stakes []*big.Int, top int) Metrics {
+	sorted := make([]*big.Int, 0, len(stakes))
+	total := new(big.Int)
+	for _, stake := range stakes {
+		if stake.Sign() > 0 {
+			sorted = append(sorted, stake)
+			total.Add(total, stake)
+		}
+	}
+
+	metrics := Metrics{Count: len(sorted), TotalStake: total}
+	if total.Sign() == 0 {
+		return metrics
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Cmp(sorted[j]) > 0
+	})
+
+	// halting of BFT consensus requires more than 1/3 of voting power
+	cumulative := new(big.Int)
+	third := new(big.Int)
+	topSum := new(big.Int)
+	for i, stake := range sorted {
+		cumulative.Add(cumulative, stake)
+		if i < top {
+			topSum.Add(topSum, stake)
+		}
+
+		if metrics.Nakamoto == 0 && third.Mul(cumulative, big.NewInt(3)).Cmp(total) > 0 {
+			metrics.Nakamoto = i + 1
+		}
+	}
+
+	metrics.TopShare = ratio(topSum, total) * 100
+	metrics.Gini = gini(sorted, total)
+
+	return metrics
+}
+
+// Gini index of stakes sorted in descending order:
+// G = 2 * sum(i * x_i) / (n * sum(x)) - (n + 1) / n for x sorted in ascending order, i from 1
+func gini(sorted []*big.Int, total *big.Int) float64 {
+	n := len(sorted)
+	weighted := new(big.Int)
+	for i, stake := range sorted {
+		rank := big.NewInt(int64(n - i))
+		weighted.Add(weighted, new(big.Int).Mul(rank, stake))
+	}
+
+	denominator := new(big.Int).Mul(total, big.NewInt(int64(n)))
+	g := 2*ratio(weighted, denominator) - float64(n+1)/float64(n)
+	if g < 0 {
+		return 0
+	}
+
+	return g
+}
+
+func ratio(x *big.Int, y *big.Int) float64 {
+	result, _ := new(big.Float).SetPrec(precision).Quo(
+		new(big.Float).SetPrec(precision).SetInt(x),
+		new(big.Float).SetPrec(precision).SetInt(y),
+	).Float64()
+
+	return result
+}
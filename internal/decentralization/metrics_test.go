@@ -0,0 +1,52 @@
+package decentralization
+
+import (
+	"math"
+	"math/big"
+	"testing"
+)
+
+func stakes(values ...int64) []*big.Int {
+	result := make([]*big.Int, len(values))
+	for i, value := range values {
+		result[i] = big.NewInt(value)
+	}
+
+	return result
+}
+
+func TestComputeEqualStakes(t *testing.T) {
+	metrics := Compute(stakes(10, 10, 10, 10), 2)
+
+	if metrics.Nakamoto != 2 {
+		t.Fatalf("expected nakamoto coefficient 2, got %d", metrics.Nakamoto)
+	}
+
+	if metrics.Gini != 0 {
+		t.Fatalf("expected gini 0, got %f", metrics.Gini)
+	}
+
+	if metrics.TopShare != 50 {
+		t.Fatalf("expected top share 50, got %f", metrics.TopShare)
+	}
+}
+
+func TestComputeDominatingStake(t *testing.T) {
+	metrics := Compute(stakes(1, 0, 97, 1, 1), 1)
+
+	if metrics.Count != 4 || metrics.Nakamoto != 1 {
+		t.Fatalf("expected 4 holders and nakamoto coefficient 1, got %d and %d", metrics.Count, metrics.Nakamoto)
+	}
+
+	// 2 * (1*1 + 2*1 + 3*1 + 4*97) / (4 * 100) - 5 / 4
+	if math.Abs(metrics.Gini-0.72) > 1e-9 {
+		t.Fatalf("unexpected gini %f", metrics.Gini)
+	}
+}
+
+func TestComputeWithoutStakes(t *testing.T) {
+	metrics := Compute(nil, 10)
+	if metrics.Count != 0 || metrics.Nakamoto != 0 || metrics.TotalStake.Sign() != 0 {
+		t.Fatalf("expected empty metrics, got %+v", metrics)
+	}
+}
@@ -0,0 +1,75 @@
+package decentralization
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+)
+
+// Metrics of active validators stakes and of delegators stakes of each validator
+type Report struct {
+	Validators Metrics
+	Delegators []ValidatorMetrics
+}
+
+type ValidatorMetrics struct {
+	Validator models.Validator
+	Metrics   Metrics
+}
+
+func NewReport(validators []models.Validator, stakes []DelegatorStake, top int) (*Report, error) {
+	delegatorStakes := make(map[uint64][]*big.Int)
+	for _, stake := range stakes {
+		value, ok := new(big.Int).SetString(stake.Stake, 10)
+		if !ok {
+			return nil, errors.NewMalformedData(fmt.Sprintf("Invalid stake %s", stake.Stake), nil)
+		}
+
+		delegatorStakes[stake.ValidatorID] = append(delegatorStakes[stake.ValidatorID], value)
+	}
+
+	validatorStakes := make([]*big.Int, 0, len(validators))
+	report := &Report{Delegators: make([]ValidatorMetrics, 0, len(validators))}
+	for _, validator := range validators {
+		if validator.TotalStake != nil {
+			value, ok := new(big.Int).SetString(*validator.TotalStake, 10)
+			if !ok {
+				return nil, errors.NewMalformedData(fmt.Sprintf("Invalid total stake %s", *validator.TotalStake), nil)
+			}
+
+			validatorStakes = append(validatorStakes, value)
+		}
+
+		report.Delegators = append(report.Delegators, ValidatorMetrics{
+			Validator: validator,
+			Metrics:   Compute(delegatorStakes[validator.ID], top),
+		})
+	}
+
+	// validators with the largest stakes first
+	sort.SliceStable(report.Delegators, func(i, j int) bool {
+		return report.Delegators[i].Metrics.TotalStake.Cmp(report.Delegators[j].Metrics.TotalStake) > 0
+	})
+
+	report.Validators = Compute(validatorStakes, top)
+
+	return report, nil
+}
+
+// Snapshot of validators metrics for the day of the time
+func (report Report) Snapshot(t time.Time) *Snapshot {
+	return &Snapshot{
+		Date:                helpers.StartOfTheDay(t),
+		ValidatorsCount:     report.Validators.Count,
+		TotalStake:          report.Validators.TotalStake.String(),
+		NakamotoCoefficient: report.Validators.Nakamoto,
+		GiniIndex:           report.Validators.Gini,
+		TopShare:            report.Validators.TopShare,
+		UpdatedAt:           t,
+	}
+}
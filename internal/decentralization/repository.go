@@ -0,0 +1,108 @@
+package decentralization
+
+import (
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+)
+
+type Repository struct {
+	db *pg.DB
+}
+
+func NewRepository(db *pg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Daily snapshot of validators stake metrics, the last snapshot of the day is kept
+type Snapshot struct {
+	tableName           struct{}  `sql:"decentralization_snapshots"`
+	Date                time.Time `sql:"date,pk,type:date"`
+	ValidatorsCount     int       `sql:"validators_count,notnull"`
+	TotalStake          string    `sql:"total_stake,notnull,type:numeric(70)"`
+	NakamotoCoefficient int       `sql:"nakamoto_coefficient,notnull"`
+	GiniIndex           float64   `sql:"gini_index,notnull"`
+	TopShare            float64   `sql:"top_share,notnull"`
+	UpdatedAt           time.Time `sql:"updated_at,notnull"`
+}
+
+// Noah value staked by the owner address to the validator
+type DelegatorStake struct {
+	ValidatorID    uint64
+	OwnerAddressID uint64
+	Stake          string
+}
+
+// Create table of daily decentralization snapshots, the metrics are computed by the api from current stakes only
+func (repository Repository) Migrate() error {
+	return repository.db.CreateTable((*Snapshot)(nil), &orm.CreateTableOptions{IfNotExists: true})
+}
+
+// Get validators by ids with total stakes
+func (repository Repository) GetValidators(ids []uint64) ([]models.Validator, error) {
+	var validators []models.Validator
+	if len(ids) == 0 {
+		return validators, nil
+	}
+
+	err := repository.db.Model(&validators).
+		Column("id", "public_key", "total_stake").
+		Where("id IN (?)", pg.In(ids)).
+		Select()
+
+	return validators, err
+}
+
+// Get stakes of validators summed by owner addresses
+func (repository Repository) GetDelegatorStakes(validatorIds []uint64) ([]DelegatorStake, error) {
+	var stakes []DelegatorStake
+	if len(validatorIds) == 0 {
+		return stakes, nil
+	}
+
+	err := repository.db.Model((*models.Stake)(nil)).
+		Column("validator_id", "owner_address_id").
+		ColumnExpr("SUM(noah_value) AS stake").
+		Where("validator_id IN (?)", pg.In(validatorIds)).
+		Group("validator_id", "owner_address_id").
+		Select(&stakes)
+
+	return stakes, err
+}
+
+// Save snapshot replacing the earlier snapshot of the same day
+func (repository Repository) SaveSnapshot(snapshot *Snapshot) error {
+	_, err := repository.db.Model(snapshot).
+		OnConflict("(date) DO UPDATE").
+		Set("validators_count = EXCLUDED.validators_count").
+		Set("total_stake = EXCLUDED.total_stake").
+		Set("nakamoto_coefficient = EXCLUDED.nakamoto_coefficient").
+		Set("gini_index = EXCLUDED.gini_index").
+		Set("top_share = EXCLUDED.top_share").
+		Set("updated_at = EXCLUDED.updated_at").
+		Insert()
+
+	return err
+}
+
+// Get daily snapshots in range ordered by date
+func (repository Repository) GetSnapshots(startTime *string, endTime *string) ([]Snapshot, error) {
+	var snapshots []Snapshot
+
+	query := repository.db.Model(&snapshots)
+	if startTime != nil {
+		query = query.Where("date >= ?::date", *startTime)
+	}
+
+	if endTime != nil {
+		query = query.Where("date <= ?::date", *endTime)
+	}
+
+	err := query.Order("date").Select()
+
+	return snapshots, err
+}
@@ -0,0 +1,78 @@
+package decentralization
+
+import (
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+)
+
+type MetricsResource struct {
+	Count               int     `json:"count"`
+	TotalStake          string  `json:"total_stake"`
+	NakamotoCoefficient int     `json:"nakamoto_coefficient"`
+	GiniIndex           float64 `json:"gini_index"`
+	TopShare            float64 `json:"top_share"`
+}
+
+func (MetricsResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	metrics := model.(Metrics)
+
+	return MetricsResource{
+		Count:               metrics.Count,
+		TotalStake:          helpers.QNoahStr2Noah(metrics.TotalStake.String()),
+		NakamotoCoefficient: metrics.Nakamoto,
+		GiniIndex:           helpers.Round(metrics.Gini, 4),
+		TopShare:            helpers.Round(metrics.TopShare, 2),
+	}
+}
+
+type ValidatorDelegatorsResource struct {
+	PublicKey  string             `json:"public_key"`
+	Delegators resource.Interface `json:"delegators"`
+}
+
+func (ValidatorDelegatorsResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	data := model.(ValidatorMetrics)
+
+	return ValidatorDelegatorsResource{
+		PublicKey:  data.Validator.GetPublicKey(),
+		Delegators: new(MetricsResource).Transform(data.Metrics),
+	}
+}
+
+type Resource struct {
+	Validators resource.Interface   `json:"validators"`
+	Delegators []resource.Interface `json:"delegators"`
+}
+
+func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	report := model.(Report)
+
+	return Resource{
+		Validators: new(MetricsResource).Transform(report.Validators),
+		Delegators: resource.TransformCollection(report.Delegators, ValidatorDelegatorsResource{}),
+	}
+}
+
+type SnapshotResource struct {
+	Date                string  `json:"date"`
+	Count               int     `json:"count"`
+	TotalStake          string  `json:"total_stake"`
+	NakamotoCoefficient int     `json:"nakamoto_coefficient"`
+	GiniIndex           float64 `json:"gini_index"`
+	TopShare            float64 `json:"top_share"`
+}
+
+func (SnapshotResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	snapshot := model.(Snapshot)
+
+	return SnapshotResource{
+		Date:                snapshot.Date.Format(time.RFC3339),
+		Count:               snapshot.ValidatorsCount,
+		TotalStake:          helpers.QNoahStr2Noah(snapshot.TotalStake),
+		NakamotoCoefficient: snapshot.NakamotoCoefficient,
+		GiniIndex:           helpers.Round(snapshot.GiniIndex, 4),
+		TopShare:            helpers.Round(snapshot.TopShare, 2),
+	}
+}
@@ -1,18 +1,39 @@
 package delegation
 
 import (
+	"math/big"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
 )
 
+const precision = 100
+
 type Resource struct {
 	Coin           string             `json:"coin"`
 	Value          string             `json:"value"`
 	NoahValue      string             `json:"noah_value"`
 	PubKey         string             `json:"pub_key"`
 	ProfitReceived string             `json:"profit_received"`
+	ProfitYield    string             `json:"profit_yield"`
 	ValidatorMeta  resource.Interface `json:"validator_meta"`
 }
 
 func (resource Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
 	return model.(Resource)
 }
+
+// Realized yield in percent of rewards received to the stake noah value, both in qNoah
+func ProfitYield(profit string, noahValue string) string {
+	value, ok := helpers.NewFloat(0, precision).SetString(noahValue)
+	if !ok || value.Sign() <= 0 {
+		return "0.00"
+	}
+
+	yield, ok := helpers.NewFloat(0, precision).SetString(profit)
+	if !ok {
+		return "0.00"
+	}
+
+	return yield.Mul(yield, big.NewFloat(100)).Quo(yield, value).Text('f', 2)
+}
@@ -0,0 +1,19 @@
+package delegation
+
+import "testing"
+
+func TestProfitYield(t *testing.T) {
+	// 1.2 NOAH of rewards to 100000 NOAH stake
+	if yield := ProfitYield("1200000000000000000", "100000000000000000000000"); yield != "0.00" {
+		t.Fatalf("expected yield 0.00, got %s", yield)
+	}
+
+	// 150 NOAH of rewards to 1000 NOAH stake
+	if yield := ProfitYield("150000000000000000000", "1000000000000000000000"); yield != "15.00" {
+		t.Fatalf("expected yield 15.00, got %s", yield)
+	}
+
+	if yield := ProfitYield("1", ""); yield != "0.00" {
+		t.Fatalf("expected zero yield without stake value, got %s", yield)
+	}
+}
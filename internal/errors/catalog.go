@@ -0,0 +1,150 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-pg/pg"
+)
+
+type Kind int
+
+const (
+	KindInternal Kind = iota
+	KindNotFound
+	KindInvalidInput
+	KindUpstreamUnavailable
+	KindTimeout
+	KindMalformedData
+)
+
+// Error with the kind which defines HTTP status and response code
+type ApiError struct {
+	Kind    Kind
+	Message string
+	Err     error
+}
+
+type kindResponse struct {
+	Status  int
+	Code    int
+	Message string // replaces error message for kinds which must not expose details
+}
+
+// Stable response codes of error kinds
+var kindResponses = map[Kind]kindResponse{
+	KindInternal:            {http.StatusInternalServerError, -1, "Internal server error"},
+	KindNotFound:            {http.StatusNotFound, http.StatusNotFound, ""},
+	KindInvalidInput:        {http.StatusBadRequest, 2, ""},
+	KindUpstreamUnavailable: {http.StatusServiceUnavailable, 3, "Service temporarily unavailable"},
+	KindTimeout:             {http.StatusGatewayTimeout, 4, "Request timed out"},
+	KindMalformedData:       {http.StatusInternalServerError, 5, "Malformed blockchain data"},
+}
+
+func (e *ApiError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s", e.Message, e.Err)
+	}
+
+	return e.Message
+}
+
+func NewNotFound(message string) error {
+	return &ApiError{Kind: KindNotFound, Message: message}
+}
+
+func NewInvalidInput(message string, err error) error {
+	return &ApiError{Kind: KindInvalidInput, Message: message, Err: err}
+}
+
+func NewMalformedData(message string, err error) error {
+	return &ApiError{Kind: KindMalformedData, Message: message, Err: err}
+}
+
+func NewUpstreamUnavailable(message string, err error) error {
+	return &ApiError{Kind: KindUpstreamUnavailable, Message: message, Err: err}
+}
+
+// Classify database and network errors, errors of the catalog are returned as is
+func Classify(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(*ApiError); ok {
+		return err
+	}
+
+	if err == pg.ErrNoRows {
+		return &ApiError{Kind: KindNotFound, Message: "Resource not found.", Err: err}
+	}
+
+	if err == context.DeadlineExceeded {
+		return &ApiError{Kind: KindTimeout, Message: "Query timed out", Err: err}
+	}
+
+	if netErr, ok := err.(net.Error); ok {
+		if netErr.Timeout() {
+			return &ApiError{Kind: KindTimeout, Message: "Database timed out", Err: err}
+		}
+
+		return &ApiError{Kind: KindUpstreamUnavailable, Message: "Database is unavailable", Err: err}
+	}
+
+	if pgErr, ok := err.(pg.Error); ok {
+		code := pgErr.Field('C')
+		switch {
+		case code == "57014": // query_canceled, e.g. by statement_timeout
+			return &ApiError{Kind: KindTimeout, Message: "Query timed out", Err: err}
+		case strings.HasPrefix(code, "08"), strings.HasPrefix(code, "53"), strings.HasPrefix(code, "57P"):
+			return &ApiError{Kind: KindUpstreamUnavailable, Message: "Database is unavailable", Err: err}
+		}
+	}
+
+	// errors of the connection pool
+	if err == io.EOF || err == io.ErrUnexpectedEOF || strings.HasPrefix(err.Error(), "pg: connection pool timeout") {
+		return &ApiError{Kind: KindTimeout, Message: "Database connection timed out", Err: err}
+	}
+
+	if strings.HasPrefix(err.Error(), "pg: database is closed") {
+		return &ApiError{Kind: KindUpstreamUnavailable, Message: "Database is unavailable", Err: err}
+	}
+
+	return &ApiError{Kind: KindInternal, Message: "Internal error", Err: err}
+}
+
+func IsNotFound(err error) bool {
+	apiErr, ok := Classify(err).(*ApiError)
+	return ok && apiErr.Kind == KindNotFound
+}
+
+// Replace not found error by the error with resource specific message
+func WithNotFoundMessage(err error, message string) error {
+	if IsNotFound(err) {
+		return NewNotFound(message)
+	}
+
+	return err
+}
+
+// Return error response by the kind of error
+func SetErrorByKind(err error, c *gin.Context) {
+	apiErr := Classify(err).(*ApiError)
+
+	response, ok := kindResponses[apiErr.Kind]
+	if !ok {
+		response = kindResponses[KindInternal]
+	}
+
+	message := response.Message
+	if message == "" {
+		message = apiErr.Message
+	}
+
+	SetErrorResponse(response.Status, response.Code, message, c)
+}
@@ -0,0 +1,55 @@
+package errors
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-pg/pg"
+)
+
+func TestClassify(t *testing.T) {
+	cases := map[error]Kind{
+		pg.ErrNoRows:             KindNotFound,
+		context.DeadlineExceeded: KindTimeout,
+		fmt.Errorf("pg: connection pool timeout"): KindTimeout,
+		fmt.Errorf("pg: database is closed"):      KindUpstreamUnavailable,
+		fmt.Errorf("unexpected"):                  KindInternal,
+		NewInvalidInput("Invalid height.", nil):   KindInvalidInput,
+	}
+
+	for err, kind := range cases {
+		if classified := Classify(err).(*ApiError); classified.Kind != kind {
+			t.Fatalf("Error %q classified as %d, expected %d", err, classified.Kind, kind)
+		}
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/missing", func(c *gin.Context) {
+		c.Error(WithNotFoundMessage(pg.ErrNoRows, "Block not found."))
+	})
+	router.GET("/panic", func(c *gin.Context) {
+		panic(NewMalformedData("Invalid check", nil))
+	})
+
+	expected := map[string]int{
+		"/missing": http.StatusNotFound,
+		"/panic":   http.StatusInternalServerError,
+	}
+
+	for path, status := range expected {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+
+		if w.Code != status {
+			t.Fatalf("Request %s responded with %d, expected %d", path, w.Code, status)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package errors
+
+import (
+	"fmt"
+	"log"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Map errors added by handlers with c.Error and recovered panics to error responses
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				err, ok := rec.(error)
+				if !ok {
+					err = fmt.Errorf("%v", rec)
+				}
+
+				log.Printf("panic recovered: %s\n%s", err, debug.Stack())
+				_ = c.Error(err)
+				c.Abort()
+
+				// streamed responses can not be replaced by error after the first write
+				if !c.Writer.Written() {
+					SetErrorByKind(err, c)
+				}
+			}
+		}()
+
+		c.Next()
+
+		if len(c.Errors) != 0 && !c.Writer.Written() {
+			SetErrorByKind(c.Errors.Last().Err, c)
+		}
+	}
+}
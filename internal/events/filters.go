@@ -16,3 +16,15 @@ func (f SelectFilter) Filter(q *orm.Query) (*orm.Query, error) {
 
 	return q.Where("address.address = ?", f.Address).Apply(blocksRange.Filter), nil
 }
+
+type ValidatorSelectFilter struct {
+	ValidatorPubKey string
+	StartBlock      *string
+	EndBlock        *string
+}
+
+func (f ValidatorSelectFilter) Filter(q *orm.Query) (*orm.Query, error) {
+	blocksRange := blocks.RangeSelectFilter{StartBlock: f.StartBlock, EndBlock: f.EndBlock}
+
+	return q.Where("validator.public_key = ?", f.ValidatorPubKey).Apply(blocksRange.Filter), nil
+}
@@ -0,0 +1,197 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/go-pg/pg/orm"
+)
+
+// Formats of exported rows
+const (
+	FormatCsv    = "csv"
+	FormatNdjson = "ndjson"
+)
+
+var contentTypes = map[string]string{
+	FormatCsv:    "text/csv; charset=utf-8",
+	FormatNdjson: "application/x-ndjson",
+}
+
+// Rows written between flushes of the response
+const flushRows = 1000
+
+// Range of exported rows of an address, empty fields are not applied
+type Filter struct {
+	Address    string
+	StartBlock *string
+	EndBlock   *string
+	StartTime  *string
+	EndTime    *string
+}
+
+// Apply block and time range to the columns of exported table
+func (f Filter) Range(blockColumn string, timeColumn string) func(*orm.Query) (*orm.Query, error) {
+	return func(q *orm.Query) (*orm.Query, error) {
+		if f.StartBlock != nil {
+			q = q.Where(blockColumn+" >= ?", *f.StartBlock)
+		}
+
+		if f.EndBlock != nil {
+			q = q.Where(blockColumn+" <= ?", *f.EndBlock)
+		}
+
+		if f.StartTime != nil {
+			q = q.Where(timeColumn+" >= ?", *f.StartTime)
+		}
+
+		if f.EndTime != nil {
+			q = q.Where(timeColumn+" <= ?", *f.EndTime)
+		}
+
+		return q, nil
+	}
+}
+
+// Streams flat rows to response, headers are sent with the first row so errors
+// before it can still be responded as usual
+type Response struct {
+	w        http.ResponseWriter
+	format   string
+	filename string
+	columns  []string
+	csv      *csv.Writer
+	json     *json.Encoder
+	rows     int
+}
+
+// Create response of rows with the type of row, which must be a flat struct with json tags
+func NewResponse(w http.ResponseWriter, format string, filename string, row interface{}) *Response {
+	if format == "" {
+		format = FormatCsv
+	}
+
+	return &Response{
+		w:        w,
+		format:   format,
+		filename: filename,
+		columns:  Columns(row),
+	}
+}
+
+func (response *Response) Started() bool {
+	return response.csv != nil || response.json != nil
+}
+
+func (response *Response) Write(row interface{}) error {
+	if !response.Started() {
+		if err := response.start(); err != nil {
+			return err
+		}
+	}
+
+	var err error
+	if response.format == FormatCsv {
+		err = response.csv.Write(Values(row))
+	} else {
+		err = response.json.Encode(row)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	response.rows++
+	if response.rows%flushRows == 0 {
+		return response.flush()
+	}
+
+	return nil
+}
+
+// Send the rest of rows, the response with no rows has only headers
+func (response *Response) Close() error {
+	if !response.Started() {
+		if err := response.start(); err != nil {
+			return err
+		}
+	}
+
+	return response.flush()
+}
+
+func (response *Response) start() error {
+	header := response.w.Header()
+	header.Set("Content-Type", contentTypes[response.format])
+	header.Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, response.filename, response.format))
+	header.Set("X-Accel-Buffering", "no")
+	response.w.WriteHeader(http.StatusOK)
+
+	if response.format == FormatNdjson {
+		response.json = json.NewEncoder(response.w)
+		return nil
+	}
+
+	response.csv = csv.NewWriter(response.w)
+	return response.csv.Write(response.columns)
+}
+
+func (response *Response) flush() error {
+	if response.csv != nil {
+		response.csv.Flush()
+		if err := response.csv.Error(); err != nil {
+			return err
+		}
+	}
+
+	if flusher, ok := response.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+
+	return nil
+}
+
+// Column names of flat row by json tags
+func Columns(row interface{}) []string {
+	t := reflect.TypeOf(row)
+	columns := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		columns = append(columns, columnName(t.Field(i)))
+	}
+
+	return columns
+}
+
+// Values of flat row fields in the order of columns, nil pointers are empty values
+func Values(row interface{}) []string {
+	v := reflect.ValueOf(row)
+	values := make([]string, 0, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Field(i)
+		if field.Kind() == reflect.Ptr {
+			if field.IsNil() {
+				values = append(values, "")
+				continue
+			}
+
+			field = field.Elem()
+		}
+
+		values = append(values, fmt.Sprint(field.Interface()))
+	}
+
+	return values
+}
+
+func columnName(field reflect.StructField) string {
+	name := strings.Split(field.Tag.Get("json"), ",")[0]
+	if name == "" {
+		return field.Name
+	}
+
+	return name
+}
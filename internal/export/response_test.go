@@ -0,0 +1,71 @@
+package export
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+type row struct {
+	Block uint64  `json:"block"`
+	To    *string `json:"to"`
+	Value string  `json:"value"`
+}
+
+func TestCsvResponse(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	response := NewResponse(recorder, "", "rows", row{})
+
+	to := "NOAHx01"
+	for _, r := range []row{{1, &to, "1.5"}, {2, nil, "2"}} {
+		if err := response.Write(r); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := response.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "block,to,value\n1,NOAHx01,1.5\n2,,2\n"
+	if recorder.Body.String() != expected {
+		t.Fatalf("expected %q, got %q", expected, recorder.Body.String())
+	}
+
+	if recorder.Header().Get("Content-Disposition") != `attachment; filename="rows.csv"` {
+		t.Fatalf("unexpected content disposition %s", recorder.Header().Get("Content-Disposition"))
+	}
+}
+
+func TestNdjsonResponse(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	response := NewResponse(recorder, FormatNdjson, "rows", row{})
+
+	if err := response.Write(row{1, nil, "1"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := response.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "{\"block\":1,\"to\":null,\"value\":\"1\"}\n"
+	if recorder.Body.String() != expected || recorder.Header().Get("Content-Type") != "application/x-ndjson" {
+		t.Fatalf("unexpected response %q", recorder.Body.String())
+	}
+}
+
+func TestEmptyResponse(t *testing.T) {
+	recorder := httptest.NewRecorder()
+	response := NewResponse(recorder, FormatCsv, "rows", row{})
+	if response.Started() {
+		t.Fatal("response must not be started before rows")
+	}
+
+	if err := response.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	if recorder.Body.String() != "block,to,value\n" {
+		t.Fatalf("empty export must have header, got %q", recorder.Body.String())
+	}
+}
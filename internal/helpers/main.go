@@ -34,3 +34,16 @@ func InArray(needle interface{}, haystack interface{}) bool {
 func StartOfTheDay(t time.Time) time.Time {
 	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
 }
+
+// Parse date or date time in one of the formats accepted by the timestamp validator
+func ParseTimestamp(timestamp string) (time.Time, error) {
+	var err error
+	for _, layout := range []string{"2006-01-02", "2006-01-02 15:04:05", time.RFC3339} {
+		var t time.Time
+		if t, err = time.Parse(layout, timestamp); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, err
+}
@@ -85,3 +85,10 @@ func GetPrice(valueStr string, priceStr string) *big.Float {
 func NewFloat(x float64, precision uint) *big.Float {
 	return big.NewFloat(x).SetPrec(precision)
 }
+
+func Noah2QNoahStr(value string) string {
+	floatValue, err := new(big.Float).SetPrec(500).SetString(value)
+	CheckErrBool(err)
+
+	return new(big.Float).SetPrec(500).Mul(floatValue, qNoahInNoah).Text('f', 0)
+}
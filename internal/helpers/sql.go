@@ -0,0 +1,8 @@
+package helpers
+
+import "strings"
+
+// Escape wildcards of LIKE pattern
+func EscapeLike(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(value)
+}
@@ -0,0 +1,121 @@
+package holder
+
+import (
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+)
+
+type Repository struct {
+	db *pg.DB
+}
+
+func NewRepository(db *pg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Daily snapshot of holders count of coin, the last snapshot of the day is kept
+type Snapshot struct {
+	tableName    struct{}  `sql:"coin_holder_snapshots"`
+	Date         time.Time `sql:"date,pk,type:date"`
+	Coin         string    `sql:"coin,pk"`
+	HoldersCount int       `sql:"holders_count,notnull"`
+	UpdatedAt    time.Time `sql:"updated_at,notnull"`
+}
+
+// Distribution of coin balances among holders, amounts are in qNoah
+type Distribution struct {
+	HoldersCount int
+	Total        string
+	Top10        string
+	Top100       string
+	Gini         float64
+}
+
+// Holders with balances of the same decimal magnitude in coins, magnitude -1 is for balances less than one coin
+type Bucket struct {
+	Magnitude    int
+	HoldersCount int
+	Amount       string
+}
+
+// Create table of daily holders counts, balances keep the current state only
+func (repository Repository) Migrate() error {
+	return repository.db.CreateTable((*Snapshot)(nil), &orm.CreateTableOptions{IfNotExists: true})
+}
+
+// Get distribution of positive balances of coin,
+// gini is computed by the ascending ranks of balances
+func (repository Repository) GetDistribution(symbol string) (*Distribution, error) {
+	var distribution Distribution
+
+	_, err := repository.db.QueryOne(&distribution, `
+		WITH h AS (
+			SELECT b.value,
+				ROW_NUMBER() OVER (ORDER BY b.value) AS asc_rank,
+				ROW_NUMBER() OVER (ORDER BY b.value DESC) AS desc_rank
+			FROM balances AS b
+			WHERE b.coin_id = (SELECT id FROM coins WHERE symbol = ?0) AND b.value > 0
+		)
+		SELECT COUNT(*) AS holders_count,
+			COALESCE(SUM(h.value), 0) AS total,
+			COALESCE(SUM(h.value) FILTER (WHERE h.desc_rank <= 10), 0) AS top10,
+			COALESCE(SUM(h.value) FILTER (WHERE h.desc_rank <= 100), 0) AS top100,
+			COALESCE(2 * SUM(h.asc_rank * h.value) / NULLIF(COUNT(*) * SUM(h.value), 0) - (COUNT(*) + 1)::numeric / NULLIF(COUNT(*), 0), 0) AS gini
+		FROM h`,
+		symbol)
+
+	return &distribution, err
+}
+
+// Get holders of coin by decimal magnitudes of balances
+func (repository Repository) GetBuckets(symbol string) ([]Bucket, error) {
+	var buckets []Bucket
+
+	_, err := repository.db.Query(&buckets, `
+		SELECT GREATEST(FLOOR(LOG(b.value / 1000000000000000000)), -1)::int AS magnitude,
+			COUNT(*) AS holders_count, SUM(b.value) AS amount
+		FROM balances AS b
+		WHERE b.coin_id = (SELECT id FROM coins WHERE symbol = ?0) AND b.value > 0
+		GROUP BY 1
+		ORDER BY 1`,
+		symbol)
+
+	return buckets, err
+}
+
+// Save holders count snapshots of all coins replacing the earlier snapshots of the same day
+func (repository Repository) SaveSnapshots(t time.Time) error {
+	_, err := repository.db.Exec(`
+		INSERT INTO coin_holder_snapshots (date, coin, holders_count, updated_at)
+		SELECT ?0::date, c.symbol, COUNT(b.coin_id), ?0
+		FROM coins AS c
+		LEFT JOIN balances AS b ON b.coin_id = c.id AND b.value > 0
+		WHERE c.deleted_at IS NULL
+		GROUP BY c.symbol
+		ON CONFLICT (date, coin) DO UPDATE SET holders_count = EXCLUDED.holders_count, updated_at = EXCLUDED.updated_at`,
+		t)
+
+	return err
+}
+
+// Get daily snapshots of coin in range ordered by date
+func (repository Repository) GetSnapshots(symbol string, startTime *string, endTime *string) ([]Snapshot, error) {
+	var snapshots []Snapshot
+
+	query := repository.db.Model(&snapshots).Where("coin = ?", symbol)
+	if startTime != nil {
+		query = query.Where("date >= ?::date", *startTime)
+	}
+
+	if endTime != nil {
+		query = query.Where("date <= ?::date", *endTime)
+	}
+
+	err := query.Order("date").Select()
+
+	return snapshots, err
+}
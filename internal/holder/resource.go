@@ -0,0 +1,74 @@
+package holder
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+)
+
+type BucketResource struct {
+	From         string `json:"from"`
+	To           string `json:"to"`
+	HoldersCount int    `json:"holders_count"`
+	Amount       string `json:"amount"`
+}
+
+func (BucketResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	bucket := model.(Bucket)
+
+	from := "0"
+	if bucket.Magnitude >= 0 {
+		from = pow10(bucket.Magnitude)
+	}
+
+	return BucketResource{
+		From:         from,
+		To:           pow10(bucket.Magnitude + 1),
+		HoldersCount: bucket.HoldersCount,
+		Amount:       helpers.QNoahStr2Noah(bucket.Amount),
+	}
+}
+
+type StatisticsResource struct {
+	HoldersCount int                  `json:"holders_count"`
+	Total        string               `json:"total"`
+	Top10Share   float64              `json:"top_10_share"`
+	Top100Share  float64              `json:"top_100_share"`
+	GiniIndex    float64              `json:"gini_index"`
+	Buckets      []resource.Interface `json:"buckets"`
+}
+
+func (StatisticsResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	statistics := model.(Statistics)
+	distribution := statistics.Distribution
+
+	return StatisticsResource{
+		HoldersCount: distribution.HoldersCount,
+		Total:        helpers.QNoahStr2Noah(distribution.Total),
+		Top10Share:   helpers.Round(Share(distribution.Top10, distribution.Total), 2),
+		Top100Share:  helpers.Round(Share(distribution.Top100, distribution.Total), 2),
+		GiniIndex:    helpers.Round(distribution.Gini, 4),
+		Buckets:      resource.TransformCollection(Histogram(statistics.Buckets), BucketResource{}),
+	}
+}
+
+type SnapshotResource struct {
+	Date         string `json:"date"`
+	HoldersCount int    `json:"holders_count"`
+}
+
+func (SnapshotResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	snapshot := model.(Snapshot)
+
+	return SnapshotResource{
+		Date:         snapshot.Date.Format(time.RFC3339),
+		HoldersCount: snapshot.HoldersCount,
+	}
+}
+
+// Power of ten in coins
+func pow10(exp int) string {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil).String()
+}
@@ -0,0 +1,59 @@
+package holder
+
+import (
+	"math/big"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+)
+
+const precision = 100
+
+// Holders statistics of coin
+type Statistics struct {
+	Distribution Distribution
+	Buckets      []Bucket
+}
+
+// Fill the missing magnitudes between the least and the largest balances with empty buckets
+func Histogram(buckets []Bucket) []Bucket {
+	if len(buckets) == 0 {
+		return buckets
+	}
+
+	byMagnitude := make(map[int]Bucket, len(buckets))
+	max := buckets[0].Magnitude
+	for _, bucket := range buckets {
+		byMagnitude[bucket.Magnitude] = bucket
+		if bucket.Magnitude > max {
+			max = bucket.Magnitude
+		}
+	}
+
+	histogram := make([]Bucket, 0, max+2)
+	for magnitude := -1; magnitude <= max; magnitude++ {
+		bucket, ok := byMagnitude[magnitude]
+		if !ok {
+			bucket = Bucket{Magnitude: magnitude, Amount: "0"}
+		}
+
+		histogram = append(histogram, bucket)
+	}
+
+	return histogram
+}
+
+// Percent of part in total, 0 for empty total
+func Share(part string, total string) float64 {
+	p, ok := helpers.NewFloat(0, precision).SetString(part)
+	if !ok {
+		return 0
+	}
+
+	t, ok := helpers.NewFloat(0, precision).SetString(total)
+	if !ok || t.Sign() <= 0 {
+		return 0
+	}
+
+	share, _ := p.Mul(p, big.NewFloat(100)).Quo(p, t).Float64()
+	return share
+}
@@ -0,0 +1,31 @@
+package holder
+
+import "testing"
+
+func TestHistogram(t *testing.T) {
+	histogram := Histogram([]Bucket{
+		{Magnitude: 2, HoldersCount: 1, Amount: "500"},
+		{Magnitude: -1, HoldersCount: 3, Amount: "1"},
+	})
+
+	expected := []int{3, 0, 0, 1}
+	if len(histogram) != len(expected) {
+		t.Fatalf("expected %d buckets, got %d", len(expected), len(histogram))
+	}
+
+	for i, bucket := range histogram {
+		if bucket.Magnitude != i-1 || bucket.HoldersCount != expected[i] {
+			t.Fatalf("unexpected bucket %v at %d", bucket, i)
+		}
+	}
+}
+
+func TestShare(t *testing.T) {
+	if share := Share("25", "200"); share != 12.5 {
+		t.Fatalf("expected share 12.5, got %v", share)
+	}
+
+	if share := Share("25", "0"); share != 0 {
+		t.Fatalf("expected no share of empty total, got %v", share)
+	}
+}
@@ -16,13 +16,13 @@ func NewRepository(db *pg.DB) *Repository {
 }
 
 // Get invalid transaction by hash
-func (repository Repository) GetTxByHash(hash string) *models.InvalidTransaction {
+func (repository Repository) GetTxByHash(hash string) (*models.InvalidTransaction, error) {
 	var transaction models.InvalidTransaction
 
 	err := repository.db.Model(&transaction).Column("FromAddress").Where("hash = ?", hash).Select()
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	return &transaction
+	return &transaction, nil
 }
@@ -0,0 +1,74 @@
+package market
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
+)
+
+// Source of the base coin price in fiat currencies
+type Feed interface {
+	GetPrice(currency string) (float64, error)
+}
+
+// Feed of the simple price API of CoinGecko or of a compatible service
+type HttpFeed struct {
+	client *tools.HttpClient
+	coinId string
+}
+
+func NewHttpFeed(url string, coinId string) *HttpFeed {
+	return &HttpFeed{
+		client: tools.NewHttpClient(url),
+		coinId: coinId,
+	}
+}
+
+func (feed *HttpFeed) GetPrice(currency string) (float64, error) {
+	currency = strings.ToLower(currency)
+
+	var prices map[string]map[string]float64
+	if err := feed.client.Get(fmt.Sprintf("simple/price?ids=%s&vs_currencies=%s", feed.coinId, currency), &prices); err != nil {
+		return 0, err
+	}
+
+	price, ok := prices[feed.coinId][currency]
+	if !ok {
+		return 0, fmt.Errorf("no %s price of %s in response", currency, feed.coinId)
+	}
+
+	return price, nil
+}
+
+// Feed of JSON file with prices by currencies, e.g. {"usd": 0.05}, the file is read on every update
+type FileFeed struct {
+	path string
+}
+
+func NewFileFeed(path string) *FileFeed {
+	return &FileFeed{
+		path: path,
+	}
+}
+
+func (feed *FileFeed) GetPrice(currency string) (float64, error) {
+	content, err := ioutil.ReadFile(feed.path)
+	if err != nil {
+		return 0, err
+	}
+
+	var prices map[string]float64
+	if err := json.Unmarshal(content, &prices); err != nil {
+		return 0, fmt.Errorf("invalid prices file %s: %s", feed.path, err)
+	}
+
+	price, ok := prices[strings.ToLower(currency)]
+	if !ok {
+		return 0, fmt.Errorf("no %s price in prices file %s", currency, feed.path)
+	}
+
+	return price, nil
+}
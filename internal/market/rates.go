@@ -0,0 +1,83 @@
+package market
+
+import (
+	"math/big"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+)
+
+const precision = 100
+
+// default amount of qNoahs in 1 Noah
+var qNoahInNoah = big.NewFloat(1000000000000000000)
+
+// Fiat values of coins by the price of the base coin,
+// custom coins are valued by their price in the base coin
+type Rates struct {
+	BaseCoin string
+	Price    *Price
+}
+
+// Fiat value of amount in the base coin, nil without price
+func (rates Rates) Value(amount string) *string {
+	value, ok := helpers.NewFloat(0, precision).SetString(amount)
+	if !ok {
+		return nil
+	}
+
+	return rates.fiat(value, 2)
+}
+
+// Fiat value of amount in qNoah of coin, nil without price of coin
+func (rates Rates) CoinValue(coin *models.Coin, amount string) *string {
+	value, ok := rates.noahValue(coin, amount)
+	if !ok {
+		return nil
+	}
+
+	return rates.fiat(value, 2)
+}
+
+// Fiat price of one coin, nil without price of coin
+func (rates Rates) CoinPrice(coin *models.Coin) *string {
+	value, ok := rates.noahValue(coin, qNoahInNoah.Text('f', 0))
+	if !ok {
+		return nil
+	}
+
+	return rates.fiat(value, 8)
+}
+
+// Base coin value of amount in qNoah of coin by the coin price
+func (rates Rates) noahValue(coin *models.Coin, amount string) (*big.Float, bool) {
+	if coin == nil {
+		return nil, false
+	}
+
+	value, ok := helpers.NewFloat(0, precision).SetString(amount)
+	if !ok {
+		return nil, false
+	}
+
+	value.Quo(value, qNoahInNoah)
+	if coin.Symbol == rates.BaseCoin {
+		return value, true
+	}
+
+	price, ok := helpers.NewFloat(0, precision).SetString(coin.Price)
+	if !ok {
+		return nil, false
+	}
+
+	return value.Mul(value, price.Quo(price, qNoahInNoah)), true
+}
+
+func (rates Rates) fiat(value *big.Float, decimals int) *string {
+	if rates.Price == nil {
+		return nil
+	}
+
+	fiat := value.Mul(value, big.NewFloat(rates.Price.Value)).Text('f', decimals)
+	return &fiat
+}
@@ -0,0 +1,32 @@
+package market
+
+import (
+	"testing"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+)
+
+func TestRatesCoinValue(t *testing.T) {
+	rates := Rates{BaseCoin: "NOAH", Price: &Price{Value: 0.5}}
+
+	// 10 NOAH
+	value := rates.CoinValue(&models.Coin{Symbol: "NOAH"}, "10000000000000000000")
+	if value == nil || *value != "5.00" {
+		t.Fatalf("expected value 5.00, got %v", value)
+	}
+
+	// 10 coins by price of 3 NOAH
+	value = rates.CoinValue(&models.Coin{Symbol: "TEST", Price: "3000000000000000000"}, "10000000000000000000")
+	if value == nil || *value != "15.00" {
+		t.Fatalf("expected value 15.00, got %v", value)
+	}
+
+	price := rates.CoinPrice(&models.Coin{Symbol: "TEST", Price: "3000000000000000000"})
+	if price == nil || *price != "1.50000000" {
+		t.Fatalf("expected price 1.50000000, got %v", price)
+	}
+
+	if (Rates{BaseCoin: "NOAH"}).Value("10") != nil {
+		t.Fatalf("expected no value without price")
+	}
+}
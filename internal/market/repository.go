@@ -0,0 +1,68 @@
+package market
+
+import (
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+)
+
+type Repository struct {
+	db *pg.DB
+}
+
+func NewRepository(db *pg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Price of the base coin in fiat currency received from the feed
+type Price struct {
+	tableName struct{}  `sql:"market_prices"`
+	Time      time.Time `sql:"time,pk"`
+	Currency  string    `sql:"currency,pk"`
+	Value     float64   `sql:"price,notnull"`
+}
+
+// Create table of fiat prices of the base coin received from the market feed
+func (repository Repository) Migrate() error {
+	return repository.db.CreateTable((*Price)(nil), &orm.CreateTableOptions{IfNotExists: true})
+}
+
+func (repository Repository) Save(price *Price) error {
+	_, err := repository.db.Model(price).OnConflict("DO NOTHING").Insert()
+	return err
+}
+
+// Get the last price of currency received not later than the time
+func (repository Repository) GetAt(currency string, t time.Time) (*Price, error) {
+	var prices []Price
+
+	err := repository.db.Model(&prices).
+		Where("currency = ?", currency).
+		Where("time <= ?", t).
+		Order("time DESC").
+		Limit(1).
+		Select()
+	if err != nil || len(prices) == 0 {
+		return nil, err
+	}
+
+	return &prices[0], nil
+}
+
+// Get prices of currency averaged by periods of the scale in range
+func (repository Repository) GetHistory(currency string, scale string, startTime time.Time, endTime *time.Time) ([]Price, error) {
+	var prices []Price
+
+	_, err := repository.db.Query(&prices, `
+		SELECT date_trunc(?1, p.time) AS time, p.currency, AVG(p.price) AS price
+		FROM market_prices AS p
+		WHERE p.currency = ?0 AND p.time >= ?2 AND (?3::timestamptz IS NULL OR p.time <= ?3)
+		GROUP BY 1, 2
+		ORDER BY 1`,
+		currency, scale, startTime, endTime)
+
+	return prices, err
+}
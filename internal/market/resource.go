@@ -0,0 +1,23 @@
+package market
+
+import (
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+)
+
+type Resource struct {
+	Time     string  `json:"time"`
+	Currency string  `json:"currency"`
+	Price    float64 `json:"price"`
+}
+
+func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	price := model.(Price)
+
+	return Resource{
+		Time:     price.Time.Format(time.RFC3339),
+		Currency: price.Currency,
+		Price:    price.Value,
+	}
+}
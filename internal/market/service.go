@@ -0,0 +1,124 @@
+package market
+
+import (
+	"context"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+)
+
+// Keeps the current price of the base coin updated from the feed and saves the price history
+type Service struct {
+	feed       Feed
+	repository Repository
+	currency   string
+	baseCoin   string
+	price      *Price
+	mutex      sync.RWMutex
+}
+
+// Create service, nil feed disables market prices
+func NewService(feed Feed, repository *Repository, currency string, baseCoin string) *Service {
+	return &Service{
+		feed:       feed,
+		repository: *repository,
+		currency:   strings.ToUpper(currency),
+		baseCoin:   baseCoin,
+	}
+}
+
+func (service *Service) Currency() string {
+	return service.currency
+}
+
+// Create table of prices
+func (service *Service) Migrate() error {
+	return service.repository.Migrate()
+}
+
+// Update price on schedule starting with the last saved price
+func (service *Service) Run(ctx context.Context) {
+	if service.feed == nil {
+		return
+	}
+
+	if price, err := service.repository.GetAt(service.currency, time.Now()); err != nil {
+		log.Printf("market: failed to get the last price: %s", err)
+	} else if price != nil {
+		service.setPrice(price)
+	}
+
+	ticker := time.NewTicker(config.MarketPriceUpdatePeriodInMin * time.Minute)
+	defer ticker.Stop()
+
+	for {
+		if err := service.update(); err != nil {
+			log.Printf("market: failed to update price: %s", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Get the current price, nil if prices are disabled or not received yet
+func (service *Service) Price() *Price {
+	service.mutex.RLock()
+	defer service.mutex.RUnlock()
+
+	return service.price
+}
+
+// Get fiat rates by the current price
+func (service *Service) Rates() Rates {
+	return Rates{BaseCoin: service.baseCoin, Price: service.Price()}
+}
+
+// Get the last price received not later than the time, nil if prices are disabled or not received by the time
+func (service *Service) PriceAt(t time.Time) (*Price, error) {
+	if service.feed == nil {
+		return nil, nil
+	}
+
+	return service.repository.GetAt(service.currency, t)
+}
+
+// Get prices averaged by periods of the scale in range
+func (service *Service) History(scale string, startTime time.Time, endTime *time.Time) ([]Price, error) {
+	if service.feed == nil {
+		return nil, nil
+	}
+
+	return service.repository.GetHistory(service.currency, scale, startTime, endTime)
+}
+
+// Get fiat rates by the last price received not later than the time
+func (service *Service) RatesAt(t time.Time) (Rates, error) {
+	price, err := service.PriceAt(t)
+	return Rates{BaseCoin: service.baseCoin, Price: price}, err
+}
+
+func (service *Service) update() error {
+	value, err := service.feed.GetPrice(service.currency)
+	if err != nil {
+		return err
+	}
+
+	price := &Price{Time: time.Now().UTC(), Currency: service.currency, Value: value}
+	service.setPrice(price)
+
+	return service.repository.Save(price)
+}
+
+func (service *Service) setPrice(price *Price) {
+	service.mutex.Lock()
+	defer service.mutex.Unlock()
+
+	service.price = price
+}
@@ -0,0 +1,72 @@
+package metrics
+
+import (
+	"bytes"
+	"math"
+)
+
+// Default buckets for request and query durations in seconds
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+type histogramData struct {
+	counts []uint64 // per bucket, not cumulative
+	count  uint64
+	sum    float64
+}
+
+type HistogramVec struct {
+	vector
+	buckets []float64
+}
+
+// Create and register histogram with sorted upper bounds of buckets
+func NewHistogramVec(name string, help string, buckets []float64, labels ...string) *HistogramVec {
+	histogram := &HistogramVec{newVector(name, help, labels), buckets}
+	DefaultRegistry.Register(histogram)
+
+	return histogram
+}
+
+func (h *HistogramVec) Observe(value float64, labelValues ...string) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	s := h.get(labelValues)
+	if s.histogram == nil {
+		s.histogram = &histogramData{counts: make([]uint64, len(h.buckets))}
+	}
+
+	for i, bound := range h.buckets {
+		if value <= bound {
+			s.histogram.counts[i]++
+			break
+		}
+	}
+
+	s.histogram.count++
+	s.histogram.sum += value
+}
+
+func (h *HistogramVec) Write(buf *bytes.Buffer) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	writeHeader(buf, h.name, h.help, "histogram")
+
+	labels := append(append([]string(nil), h.labels...), "le")
+	for _, s := range h.sorted() {
+		values := append(append([]string(nil), s.labelValues...), "")
+
+		var cumulative uint64
+		for i, bound := range h.buckets {
+			cumulative += s.histogram.counts[i]
+			values[len(values)-1] = formatFloat(bound)
+			writeSample(buf, h.name+"_bucket", labels, values, float64(cumulative))
+		}
+
+		values[len(values)-1] = formatFloat(math.Inf(1))
+		writeSample(buf, h.name+"_bucket", labels, values, float64(s.histogram.count))
+		writeSample(buf, h.name+"_sum", h.labels, s.labelValues, s.histogram.sum)
+		writeSample(buf, h.name+"_count", h.labels, s.labelValues, float64(s.histogram.count))
+	}
+}
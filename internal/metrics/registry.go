@@ -0,0 +1,110 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+const contentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// Collector writes its samples in the Prometheus text exposition format
+type Collector interface {
+	Name() string
+	Write(buf *bytes.Buffer)
+}
+
+type Registry struct {
+	collectors map[string]Collector
+	mutex      sync.RWMutex
+}
+
+// Registry used by the metric constructors of this package
+var DefaultRegistry = NewRegistry()
+
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]Collector)}
+}
+
+// Register collector, panics on duplicated metric names
+func (r *Registry) Register(collector Collector) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.collectors[collector.Name()]; ok {
+		panic(fmt.Sprintf("metrics: collector %s is already registered", collector.Name()))
+	}
+
+	r.collectors[collector.Name()] = collector
+}
+
+// Write all registered metrics ordered by name
+func (r *Registry) Write(buf *bytes.Buffer) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	names := make([]string, 0, len(r.collectors))
+	for name := range r.collectors {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		r.collectors[name].Write(buf)
+	}
+}
+
+// Serve metrics of the default registry
+func Handler(c *gin.Context) {
+	var buf bytes.Buffer
+	DefaultRegistry.Write(&buf)
+
+	c.Data(http.StatusOK, contentType, buf.Bytes())
+}
+
+func writeHeader(buf *bytes.Buffer, name string, help string, metricType string) {
+	fmt.Fprintf(buf, "# HELP %s %s\n", name, escapeHelp(help))
+	fmt.Fprintf(buf, "# TYPE %s %s\n", name, metricType)
+}
+
+func writeSample(buf *bytes.Buffer, name string, labels []string, values []string, value float64) {
+	buf.WriteString(name)
+	if len(labels) != 0 {
+		buf.WriteByte('{')
+		for i, label := range labels {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+
+			fmt.Fprintf(buf, "%s=\"%s\"", label, escapeLabelValue(values[i]))
+		}
+		buf.WriteByte('}')
+	}
+
+	buf.WriteByte(' ')
+	buf.WriteString(formatFloat(value))
+	buf.WriteByte('\n')
+}
+
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func escapeHelp(help string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`).Replace(value)
+}
+
+// Label values joined into a map key
+func labelsKey(values []string) string {
+	return strings.Join(values, "\xff")
+}
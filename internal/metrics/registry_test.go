@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRegistryWrite(t *testing.T) {
+	registry := NewRegistry()
+
+	counter := &CounterVec{newVector("test_requests_total", "Requests.", []string{"route"})}
+	registry.Register(counter)
+	counter.Inc(`/a"b`)
+	counter.Add(2, "/c")
+
+	histogram := &HistogramVec{newVector("test_duration_seconds", "Duration.", []string{"route"}), []float64{0.1, 1}}
+	registry.Register(histogram)
+	histogram.Observe(0.05, "/c")
+	histogram.Observe(0.5, "/c")
+	histogram.Observe(5, "/c")
+
+	var buf bytes.Buffer
+	registry.Write(&buf)
+
+	expected := strings.Join([]string{
+		"# HELP test_duration_seconds Duration.",
+		"# TYPE test_duration_seconds histogram",
+		`test_duration_seconds_bucket{route="/c",le="0.1"} 1`,
+		`test_duration_seconds_bucket{route="/c",le="1"} 2`,
+		`test_duration_seconds_bucket{route="/c",le="+Inf"} 3`,
+		`test_duration_seconds_sum{route="/c"} 5.55`,
+		`test_duration_seconds_count{route="/c"} 3`,
+		"# HELP test_requests_total Requests.",
+		"# TYPE test_requests_total counter",
+		`test_requests_total{route="/a\"b"} 1`,
+		`test_requests_total{route="/c"} 2`,
+		"",
+	}, "\n")
+
+	if buf.String() != expected {
+		t.Fatalf("Unexpected exposition output:\n%s", buf.String())
+	}
+}
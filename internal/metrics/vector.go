@@ -0,0 +1,163 @@
+package metrics
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Samples of one metric split by label values
+type vector struct {
+	name   string
+	help   string
+	labels []string
+	series map[string]*series
+	mutex  sync.Mutex
+}
+
+type series struct {
+	labelValues []string
+	value       float64
+	histogram   *histogramData
+}
+
+func newVector(name string, help string, labels []string) vector {
+	return vector{
+		name:   name,
+		help:   help,
+		labels: labels,
+		series: make(map[string]*series),
+	}
+}
+
+func (v *vector) Name() string {
+	return v.name
+}
+
+// Get series by label values, caller must hold the mutex
+func (v *vector) get(labelValues []string) *series {
+	if len(labelValues) != len(v.labels) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", v.name, len(v.labels), len(labelValues)))
+	}
+
+	key := labelsKey(labelValues)
+	s, ok := v.series[key]
+	if !ok {
+		s = &series{labelValues: append([]string(nil), labelValues...)}
+		v.series[key] = s
+	}
+
+	return s
+}
+
+// Series ordered by label values, caller must hold the mutex
+func (v *vector) sorted() []*series {
+	keys := make([]string, 0, len(v.series))
+	for key := range v.series {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	result := make([]*series, len(keys))
+	for i, key := range keys {
+		result[i] = v.series[key]
+	}
+
+	return result
+}
+
+type CounterVec struct {
+	vector
+}
+
+// Create and register monotonically increasing counter
+func NewCounterVec(name string, help string, labels ...string) *CounterVec {
+	counter := &CounterVec{newVector(name, help, labels)}
+	DefaultRegistry.Register(counter)
+
+	return counter
+}
+
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+func (c *CounterVec) Add(delta float64, labelValues ...string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.get(labelValues).value += delta
+}
+
+func (c *CounterVec) Write(buf *bytes.Buffer) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	writeHeader(buf, c.name, c.help, "counter")
+	for _, s := range c.sorted() {
+		writeSample(buf, c.name, c.labels, s.labelValues, s.value)
+	}
+}
+
+type GaugeVec struct {
+	vector
+}
+
+// Create and register gauge
+func NewGaugeVec(name string, help string, labels ...string) *GaugeVec {
+	gauge := &GaugeVec{newVector(name, help, labels)}
+	DefaultRegistry.Register(gauge)
+
+	return gauge
+}
+
+func (g *GaugeVec) Set(value float64, labelValues ...string) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.get(labelValues).value = value
+}
+
+func (g *GaugeVec) Write(buf *bytes.Buffer) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	writeHeader(buf, g.name, g.help, "gauge")
+	for _, s := range g.sorted() {
+		writeSample(buf, g.name, g.labels, s.labelValues, s.value)
+	}
+}
+
+// Metric without labels which value is read on every scrape
+type Func struct {
+	name       string
+	help       string
+	metricType string
+	value      func() float64
+}
+
+// Create and register gauge reading its value from callback
+func NewGaugeFunc(name string, help string, value func() float64) *Func {
+	gauge := &Func{name: name, help: help, metricType: "gauge", value: value}
+	DefaultRegistry.Register(gauge)
+
+	return gauge
+}
+
+// Create and register counter reading its value from callback
+func NewCounterFunc(name string, help string, value func() float64) *Func {
+	counter := &Func{name: name, help: help, metricType: "counter", value: value}
+	DefaultRegistry.Register(counter)
+
+	return counter
+}
+
+func (f *Func) Name() string {
+	return f.name
+}
+
+func (f *Func) Write(buf *bytes.Buffer) {
+	writeHeader(buf, f.name, f.help, f.metricType)
+	writeSample(buf, f.name, nil, nil, f.value())
+}
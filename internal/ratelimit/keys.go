@@ -0,0 +1,160 @@
+package ratelimit
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// Limits of a group of clients
+type Tier struct {
+	Name       string  `json:"-"`
+	Rate       float64 `json:"rate"`        // requests per second
+	Burst      int     `json:"burst"`       // requests allowed at once
+	DailyQuota int     `json:"daily_quota"` // requests per UTC day, zero is unlimited
+}
+
+type Key struct {
+	Key   string `json:"key"`
+	Owner string `json:"owner"`
+	Tier  string `json:"tier"`
+}
+
+// Content of API keys file
+type KeysConfig struct {
+	Anonymous *Tier           `json:"anonymous"`
+	Tiers     map[string]Tier `json:"tiers"`
+	Keys      []Key           `json:"keys"`
+}
+
+// Tiers of API keys loaded from the keys file
+type KeyStore struct {
+	path      string
+	modTime   time.Time
+	anonymous Tier
+	keys      map[string]Tier
+	mutex     sync.RWMutex
+}
+
+const AnonymousTier = "anonymous"
+
+// Create key store with only anonymous tier if path is empty
+func NewKeyStore(path string, anonymous Tier) (*KeyStore, error) {
+	anonymous.Name = AnonymousTier
+	store := &KeyStore{
+		path:      path,
+		anonymous: anonymous,
+		keys:      make(map[string]Tier),
+	}
+
+	if path == "" {
+		return store, nil
+	}
+
+	if _, err := store.Reload(); err != nil {
+		return nil, err
+	}
+
+	return store, nil
+}
+
+// Get tier of API key
+func (store *KeyStore) Lookup(key string) (Tier, bool) {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	tier, ok := store.keys[key]
+	return tier, ok
+}
+
+func (store *KeyStore) Anonymous() Tier {
+	store.mutex.RLock()
+	defer store.mutex.RUnlock()
+
+	return store.anonymous
+}
+
+// Reload keys file if it was modified, the previous keys are kept on errors
+func (store *KeyStore) Reload() (bool, error) {
+	info, err := os.Stat(store.path)
+	if err != nil {
+		return false, err
+	}
+
+	store.mutex.RLock()
+	modified := !info.ModTime().Equal(store.modTime)
+	store.mutex.RUnlock()
+
+	if !modified {
+		return false, nil
+	}
+
+	content, err := ioutil.ReadFile(store.path)
+	if err != nil {
+		return false, err
+	}
+
+	var config KeysConfig
+	if err := json.Unmarshal(content, &config); err != nil {
+		return false, fmt.Errorf("invalid keys file %s: %s", store.path, err)
+	}
+
+	keys, err := config.tiersByKey()
+	if err != nil {
+		return false, fmt.Errorf("invalid keys file %s: %s", store.path, err)
+	}
+
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.keys = keys
+	store.modTime = info.ModTime()
+	if config.Anonymous != nil {
+		store.anonymous = *config.Anonymous
+		store.anonymous.Name = AnonymousTier
+	}
+
+	return true, nil
+}
+
+func (config KeysConfig) tiersByKey() (map[string]Tier, error) {
+	for name, tier := range config.Tiers {
+		if err := tier.validate(); err != nil {
+			return nil, fmt.Errorf("tier %s: %s", name, err)
+		}
+	}
+
+	if config.Anonymous != nil {
+		if err := config.Anonymous.validate(); err != nil {
+			return nil, fmt.Errorf("tier %s: %s", AnonymousTier, err)
+		}
+	}
+
+	keys := make(map[string]Tier, len(config.Keys))
+	for _, key := range config.Keys {
+		tier, ok := config.Tiers[key.Tier]
+		if !ok {
+			return nil, fmt.Errorf("unknown tier %q of key owned by %q", key.Tier, key.Owner)
+		}
+
+		if key.Key == "" {
+			return nil, fmt.Errorf("empty key owned by %q", key.Owner)
+		}
+
+		tier.Name = key.Tier
+		keys[key.Key] = tier
+	}
+
+	return keys, nil
+}
+
+func (tier Tier) validate() error {
+	if tier.Rate <= 0 || tier.Burst <= 0 || tier.DailyQuota < 0 {
+		return fmt.Errorf("rate and burst must be positive, daily quota must not be negative")
+	}
+
+	return nil
+}
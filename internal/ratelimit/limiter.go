@@ -0,0 +1,179 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"log"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+)
+
+var ErrUnknownKey = errors.New("unknown API key")
+
+// Result of rate limit check, used for X-RateLimit-* headers
+type Result struct {
+	Tier           Tier
+	Allowed        bool
+	Remaining      int           // requests allowed at once after this one
+	Reset          time.Duration // time until the bucket is full
+	RetryAfter     time.Duration // time until the next request is allowed
+	QuotaRemaining int           // requests left for today if tier has daily quota
+	QuotaReset     time.Time
+}
+
+// Token bucket and daily usage of one client
+type bucket struct {
+	tier     Tier
+	tokens   float64
+	updated  time.Time
+	day      time.Time
+	used     int
+	lastSeen time.Time
+}
+
+// Limits requests of API keys and anonymous clients by IP
+type Limiter struct {
+	keys    *KeyStore
+	buckets map[string]*bucket
+	mutex   sync.Mutex
+}
+
+func NewLimiter(keys *KeyStore) *Limiter {
+	return &Limiter{
+		keys:    keys,
+		buckets: make(map[string]*bucket),
+	}
+}
+
+// Check limits of API key, requests without key are limited by IP with anonymous tier
+func (limiter *Limiter) Allow(key string, ip string, now time.Time) (Result, error) {
+	id, tier := "ip:"+ip, limiter.keys.Anonymous()
+	if key != "" {
+		var ok bool
+		if tier, ok = limiter.keys.Lookup(key); !ok {
+			return Result{}, ErrUnknownKey
+		}
+
+		id = "key:" + key
+	}
+
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	b, ok := limiter.buckets[id]
+	if !ok {
+		b = &bucket{tier: tier, tokens: float64(tier.Burst), updated: now}
+		limiter.buckets[id] = b
+	}
+
+	return b.take(tier, now), nil
+}
+
+func (b *bucket) take(tier Tier, now time.Time) Result {
+	// tier of the key may change after keys file reload
+	b.tier = tier
+	b.lastSeen = now
+
+	b.tokens = math.Min(float64(tier.Burst), b.tokens+now.Sub(b.updated).Seconds()*tier.Rate)
+	b.updated = now
+
+	day := startOfDay(now)
+	if !day.Equal(b.day) {
+		b.day, b.used = day, 0
+	}
+
+	result := Result{Tier: tier, QuotaReset: day.AddDate(0, 0, 1)}
+	quotaExceeded := tier.DailyQuota > 0 && b.used >= tier.DailyQuota
+
+	if b.tokens >= 1 && !quotaExceeded {
+		b.tokens--
+		b.used++
+		result.Allowed = true
+	}
+
+	result.Remaining = int(b.tokens)
+	result.Reset = secondsDuration((float64(tier.Burst) - b.tokens) / tier.Rate)
+
+	if tier.DailyQuota > 0 {
+		result.QuotaRemaining = tier.DailyQuota - b.used
+	}
+
+	if !result.Allowed {
+		result.RetryAfter = secondsDuration((1 - b.tokens) / tier.Rate)
+		if quotaExceeded {
+			result.RetryAfter = result.QuotaReset.Sub(now)
+		}
+	}
+
+	return result
+}
+
+// Remove buckets of clients idle for the period, unless they used the daily quota today
+func (limiter *Limiter) EvictIdle(idle time.Duration, now time.Time) int {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	today := startOfDay(now)
+	evicted := 0
+	for id, b := range limiter.buckets {
+		if now.Sub(b.lastSeen) < idle || (b.tier.DailyQuota > 0 && b.day.Equal(today)) {
+			continue
+		}
+
+		delete(limiter.buckets, id)
+		evicted++
+	}
+
+	return evicted
+}
+
+func (limiter *Limiter) Len() int {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	return len(limiter.buckets)
+}
+
+// Evict idle buckets and reload keys file until the context is done
+func (limiter *Limiter) Run(ctx context.Context) {
+	evictTicker := time.NewTicker(config.RateLimitEvictionPeriodInSec * time.Second)
+	defer evictTicker.Stop()
+
+	reloadTicker := time.NewTicker(config.ApiKeysReloadPeriodInSec * time.Second)
+	defer reloadTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-evictTicker.C:
+			limiter.EvictIdle(config.RateLimitIdleTimeoutInSec*time.Second, now)
+		case <-reloadTicker.C:
+			if limiter.keys.path == "" {
+				continue
+			}
+
+			if reloaded, err := limiter.keys.Reload(); err != nil {
+				log.Printf("ratelimit: failed to reload API keys: %s", err)
+			} else if reloaded {
+				log.Printf("ratelimit: API keys reloaded from %s", limiter.keys.path)
+			}
+		}
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func secondsDuration(seconds float64) time.Duration {
+	if seconds <= 0 {
+		return 0
+	}
+
+	return time.Duration(seconds * float64(time.Second))
+}
@@ -0,0 +1,102 @@
+package ratelimit
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+const keysFile = `{
+	"anonymous": {"rate": 1, "burst": 2},
+	"tiers": {"partner": {"rate": 10, "burst": 10, "daily_quota": 3}},
+	"keys": [{"key": "secret", "owner": "partner", "tier": "partner"}]
+}`
+
+func newTestLimiter(t *testing.T) *Limiter {
+	file, err := ioutil.TempFile("", "keys")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(keysFile); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := NewKeyStore(file.Name(), Tier{Rate: 100, Burst: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	os.Remove(file.Name())
+	return NewLimiter(keys)
+}
+
+func TestAnonymousBucket(t *testing.T) {
+	limiter := newTestLimiter(t)
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 2; i++ {
+		if result, _ := limiter.Allow("", "127.0.0.1", now); !result.Allowed {
+			t.Fatalf("request %d must be allowed", i)
+		}
+	}
+
+	result, _ := limiter.Allow("", "127.0.0.1", now)
+	if result.Allowed || result.RetryAfter != time.Second || result.Tier.Name != AnonymousTier {
+		t.Fatalf("unexpected result of exceeded burst: %+v", result)
+	}
+
+	if result, _ := limiter.Allow("", "127.0.0.2", now); !result.Allowed {
+		t.Fatal("other ip must have own bucket")
+	}
+
+	if result, _ := limiter.Allow("", "127.0.0.1", now.Add(time.Second)); !result.Allowed {
+		t.Fatal("bucket must be refilled by rate")
+	}
+}
+
+func TestDailyQuota(t *testing.T) {
+	limiter := newTestLimiter(t)
+	now := time.Date(2019, 10, 1, 23, 0, 0, 0, time.UTC)
+
+	for i := 0; i < 3; i++ {
+		result, _ := limiter.Allow("secret", "127.0.0.1", now)
+		if !result.Allowed || result.QuotaRemaining != 2-i {
+			t.Fatalf("unexpected result of request %d: %+v", i, result)
+		}
+	}
+
+	result, _ := limiter.Allow("secret", "127.0.0.1", now)
+	if result.Allowed || result.RetryAfter != time.Hour {
+		t.Fatalf("quota must be exceeded until the end of day: %+v", result)
+	}
+
+	if result, _ := limiter.Allow("secret", "127.0.0.1", now.Add(time.Hour)); !result.Allowed {
+		t.Fatal("quota must be reset at the start of day")
+	}
+}
+
+func TestUnknownKey(t *testing.T) {
+	limiter := newTestLimiter(t)
+	if _, err := limiter.Allow("unknown", "127.0.0.1", time.Now()); err != ErrUnknownKey {
+		t.Fatalf("expected unknown key error, got %v", err)
+	}
+}
+
+func TestEvictIdle(t *testing.T) {
+	limiter := newTestLimiter(t)
+	now := time.Date(2019, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	limiter.Allow("", "127.0.0.1", now)
+	limiter.Allow("secret", "127.0.0.1", now)
+
+	if evicted := limiter.EvictIdle(time.Minute, now.Add(time.Hour)); evicted != 1 {
+		t.Fatalf("only anonymous bucket must be evicted, evicted %d", evicted)
+	}
+
+	if evicted := limiter.EvictIdle(time.Minute, now.Add(24*time.Hour)); evicted != 1 || limiter.Len() != 0 {
+		t.Fatalf("bucket with quota must be evicted on the next day, evicted %d", evicted)
+	}
+}
@@ -23,6 +23,7 @@ type PaginationMetaResource struct {
 	Path        string                 `json:"path"`
 	PerPage     int                    `json:"per_page"`
 	Total       int                    `json:"total"`
+	NextCursor  *string                `json:"next_cursor,omitempty"`
 	Additional  map[string]interface{} `json:"additional,omitempty"`
 }
 
@@ -34,9 +35,15 @@ func TransformPaginatedCollectionWithAdditionalFields(collection interface{}, re
 	return transformPaginatedCollection(collection, resource, pagination, additional)
 }
 
+func TransformPaginatedCollectionWithCallback(collection interface{}, resource Interface, pagination tools.Pagination, callbackFunc func(model ParamInterface) ParamsInterface) PaginationResource {
+	return newPaginationResource(TransformCollectionWithCallback(collection, resource, callbackFunc), pagination, nil)
+}
+
 func transformPaginatedCollection(collection interface{}, resource Interface, pagination tools.Pagination, additional map[string]interface{}) PaginationResource {
-	result := TransformCollection(collection, resource)
+	return newPaginationResource(TransformCollection(collection, resource), pagination, additional)
+}
 
+func newPaginationResource(result []Interface, pagination tools.Pagination, additional map[string]interface{}) PaginationResource {
 	return PaginationResource{
 		Data: result,
 		Links: PaginationLinksResource{
@@ -51,6 +58,7 @@ func transformPaginatedCollection(collection interface{}, resource Interface, pa
 			Path:        pagination.GetPath(),
 			PerPage:     pagination.GetPerPage(),
 			Total:       pagination.Total,
+			NextCursor:  pagination.GetNextCursor(),
 			Additional:  additional,
 		},
 	}
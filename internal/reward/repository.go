@@ -1,17 +1,24 @@
 package reward
 
 import (
-	"fmt"
+	"context"
 	"time"
 
 	"github.com/go-pg/pg"
 	"github.com/noah-blockchain/coinExplorer-tools/models"
 	"github.com/noah-blockchain/noah-explorer-api/internal/aggregated_reward"
 	"github.com/noah-blockchain/noah-explorer-api/internal/events"
-	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/export"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
 )
 
+const (
+	RoleValidator  = "Validator"
+	RoleDelegator  = "Delegator"
+	RoleDAO        = "DAO"
+	RoleDevelopers = "Developers"
+)
+
 type Repository struct {
 	db *pg.DB
 }
@@ -23,7 +30,7 @@ func NewRepository(db *pg.DB) *Repository {
 }
 
 // Get filtered list of rewards by Noah address
-func (repository Repository) GetPaginatedByAddress(filter events.SelectFilter, pagination *tools.Pagination) []models.Reward {
+func (repository Repository) GetPaginatedByAddress(filter events.SelectFilter, pagination *tools.Pagination) ([]models.Reward, error) {
 	var rewards []models.Reward
 	var err error
 
@@ -32,10 +39,8 @@ func (repository Repository) GetPaginatedByAddress(filter events.SelectFilter, p
 		Column("Address.address").
 		Apply(filter.Filter).
 		Count()
-	helpers.CheckErr(err)
-
-	if pagination.Total == 0 {
-		return nil
+	if err != nil || pagination.Total == 0 {
+		return nil, err
 	}
 
 	// get rewards
@@ -47,9 +52,8 @@ func (repository Repository) GetPaginatedByAddress(filter events.SelectFilter, p
 		Order("block.id DESC").
 		Order("reward.amount").
 		Select()
-	helpers.CheckErr(err)
 
-	return rewards
+	return rewards, err
 }
 
 type ChartData struct {
@@ -58,7 +62,7 @@ type ChartData struct {
 }
 
 // Get filtered chart data by Noah address
-func (repository Repository) GetChartData(address string, filter tools.Filter) []ChartData {
+func (repository Repository) GetChartData(address string, filter tools.Filter) ([]ChartData, error) {
 	var rewards models.Reward
 	var chartData []ChartData
 
@@ -69,12 +73,10 @@ func (repository Repository) GetChartData(address string, filter tools.Filter) [
 		Apply(filter.Filter).
 		Select(&chartData)
 
-	helpers.CheckErr(err)
-
-	return chartData
+	return chartData, err
 }
 
-func (repository Repository) GetAggregatedChartData(filter aggregated_reward.SelectFilter) []ChartData {
+func (repository Repository) GetAggregatedChartData(filter aggregated_reward.SelectFilter) ([]ChartData, error) {
 	var rewards models.AggregatedReward
 	var chartData []ChartData
 
@@ -87,12 +89,10 @@ func (repository Repository) GetAggregatedChartData(filter aggregated_reward.Sel
 		Apply(filter.Filter).
 		Select(&chartData)
 
-	helpers.CheckErr(err)
-
-	return chartData
+	return chartData, err
 }
 
-func (repository Repository) GetPaginatedAggregatedByAddress(filter aggregated_reward.SelectFilter, pagination *tools.Pagination) []models.AggregatedReward {
+func (repository Repository) GetPaginatedAggregatedByAddress(filter aggregated_reward.SelectFilter, pagination *tools.Pagination) ([]models.AggregatedReward, error) {
 	var rewards []models.AggregatedReward
 	var err error
 
@@ -105,26 +105,139 @@ func (repository Repository) GetPaginatedAggregatedByAddress(filter aggregated_r
 		Order("amount").
 		SelectAndCount()
 
-	helpers.CheckErr(err)
+	return rewards, err
+}
 
-	return rewards
+// Get chart data of rewards paid by validator, of all roles if the role is empty
+func (repository Repository) GetChartDataByValidator(publicKey string, role string, filter tools.Filter) ([]ChartData, error) {
+	var rewards models.Reward
+	var chartData []ChartData
+
+	query := repository.db.Model(&rewards).
+		Column("Validator._").
+		ColumnExpr("SUM(amount) as amount").
+		Where("validator.public_key = ?", publicKey)
+
+	if role != "" {
+		query = query.Where("role = ?", role)
+	}
+
+	err := query.Apply(filter.Filter).Select(&chartData)
+
+	return chartData, err
 }
 
-func (repository Repository) GetSumRewardForValidator(validatorId uint64, createdAt time.Time) string {
-	var reward models.Reward
-	var total = "0"
+// Sum of rewards paid by validator to the role for the day
+type DailyRoleRewards struct {
+	Time   time.Time
+	Role   string
+	Amount string
+}
 
-	// get total stake of active validators
-	err := repository.db.Model(&reward).
-		ColumnExpr("SUM(amount)").
-		Where("role = ?", "Validator").
-		Where("created_at >= ?", createdAt).
-		Where("validator_id = ?", validatorId).
-		Select(&total)
-	if err != nil {
-		fmt.Println(err)
-		return "0"
+// Get daily sums of rewards paid by validator by roles
+func (repository Repository) GetDailyByValidator(filter aggregated_reward.ValidatorSelectFilter) ([]DailyRoleRewards, error) {
+	var rewards models.AggregatedReward
+	var data []DailyRoleRewards
+
+	err := repository.db.Model(&rewards).
+		Column("Validator._", "role").
+		ColumnExpr("time_id as time").
+		ColumnExpr("SUM(amount) as amount").
+		Apply(filter.Filter).
+		Group("time", "role").
+		Order("time").
+		Select(&data)
+
+	return data, err
+}
+
+// Delegator rewards received by stake
+type StakeProfit struct {
+	StakeID uint64
+	Amount  string
+}
+
+// Get delegator rewards of address per stake since the stake creation, rewards of validator
+// are shared by noah value between stakes existing at the time, windows start at stake creations.
+// Rewards of unbonded stakes are shared between the remaining stakes.
+func (repository Repository) GetDelegatorProfitsByStakes(address string, stakeIds []uint64) ([]StakeProfit, error) {
+	var profits []StakeProfit
+	if len(stakeIds) == 0 {
+		return profits, nil
 	}
 
-	return total
+	_, err := repository.db.Query(&profits, `
+		WITH st AS (
+			SELECT s.id, s.owner_address_id, s.validator_id, s.noah_value, s.created_at
+			FROM stakes AS s INNER JOIN addresses AS a ON a.id = s.owner_address_id
+			WHERE a.address = ?0
+		), points AS (
+			SELECT DISTINCT owner_address_id, validator_id, created_at FROM st
+		), windows AS (
+			SELECT owner_address_id, validator_id, created_at AS start_at,
+				LEAD(created_at) OVER (PARTITION BY validator_id ORDER BY created_at) AS end_at
+			FROM points
+		), window_rewards AS (
+			SELECT w.validator_id, w.start_at, SUM(r.amount) AS amount
+			FROM windows AS w
+			INNER JOIN rewards AS r ON r.address_id = w.owner_address_id AND r.validator_id = w.validator_id
+				AND r.role = ?1 AND r.created_at >= w.start_at AND (w.end_at IS NULL OR r.created_at < w.end_at)
+			GROUP BY w.validator_id, w.start_at
+		)
+		SELECT st.id AS stake_id, COALESCE(floor(SUM(wr.amount * st.noah_value / NULLIF(total.noah_value, 0))), 0) AS amount
+		FROM st
+		LEFT JOIN window_rewards AS wr ON wr.validator_id = st.validator_id AND wr.start_at >= st.created_at
+		LEFT JOIN LATERAL (
+			SELECT SUM(o.noah_value) AS noah_value FROM st AS o
+			WHERE o.validator_id = st.validator_id AND o.created_at <= wr.start_at
+		) AS total ON TRUE
+		WHERE st.id IN (?2)
+		GROUP BY st.id`,
+		address, RoleDelegator, pg.In(stakeIds))
+
+	return profits, err
+}
+
+// Sum of delegator rewards of validator
+type ValidatorRewards struct {
+	ValidatorID uint64
+	Amount      string
+}
+
+// Get sums of delegator rewards by validators of days in range [since, until)
+func (repository Repository) GetDelegatorRewardsByValidators(since time.Time, until time.Time) ([]ValidatorRewards, error) {
+	var rewards []ValidatorRewards
+
+	err := repository.db.Model((*models.AggregatedReward)(nil)).
+		Column("validator_id").
+		ColumnExpr("SUM(amount) AS amount").
+		Where("role = ?", RoleDelegator).
+		Where("time_id >= ?", since).
+		Where("time_id < ?", until).
+		Group("validator_id").
+		Select(&rewards)
+
+	return rewards, err
+}
+
+// Reward row of export
+type ExportRow struct {
+	BlockID   uint64
+	CreatedAt time.Time
+	Role      string
+	Amount    string
+	Validator string
+}
+
+// Stream rewards of address from the latest
+func (repository Repository) ForEachByAddress(ctx context.Context, filter export.Filter, fn func(*ExportRow) error) error {
+	return repository.db.Model((*models.Reward)(nil)).
+		Context(ctx).
+		ColumnExpr("reward.block_id, block.created_at, reward.role, reward.amount, validator.public_key AS validator").
+		Join("INNER JOIN blocks AS block ON block.id = reward.block_id").
+		Join("INNER JOIN validators AS validator ON validator.id = reward.validator_id").
+		Where("reward.address_id = (SELECT id FROM addresses WHERE address = ?)", filter.Address).
+		Apply(filter.Range("reward.block_id", "block.created_at")).
+		Order("reward.block_id DESC", "reward.amount").
+		ForEach(fn)
 }
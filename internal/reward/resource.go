@@ -1,6 +1,7 @@
 package reward
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/noah-blockchain/coinExplorer-tools/models"
@@ -32,3 +33,73 @@ func (Resource) Transform(model resource.ItemInterface, params ...resource.Param
 		ValidatorMeta: new(validatorMeta.Resource).Transform(*reward.Validator),
 	}
 }
+
+// Flat row of reward for export
+type ExportResource struct {
+	BlockID   uint64 `json:"block"`
+	Timestamp string `json:"timestamp"`
+	Role      string `json:"role"`
+	Amount    string `json:"amount"`
+	Validator string `json:"validator"`
+}
+
+func (ExportResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	row := model.(ExportRow)
+
+	return ExportResource{
+		BlockID:   row.BlockID,
+		Timestamp: row.CreatedAt.Format(time.RFC3339),
+		Role:      row.Role,
+		Amount:    helpers.QNoahStr2Noah(row.Amount),
+		Validator: `Np` + row.Validator,
+	}
+}
+
+// Rewards paid by validator for the day by roles
+type DailyResource struct {
+	Time       string `json:"time"`
+	Validator  string `json:"validator"`
+	Delegator  string `json:"delegator"`
+	DAO        string `json:"dao"`
+	Developers string `json:"developers"`
+	Total      string `json:"total"`
+}
+
+func (DailyResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	return model.(DailyResource)
+}
+
+// Group sums of rewards ordered by day into one resource per day
+func TransformDailyByRoles(rows []DailyRoleRewards) []DailyResource {
+	resources := make([]DailyResource, 0)
+	totals := make([]*big.Int, 0)
+
+	for _, row := range rows {
+		day := row.Time.Format(time.RFC3339)
+		if len(resources) == 0 || resources[len(resources)-1].Time != day {
+			resources = append(resources, DailyResource{day, "0", "0", "0", "0", "0"})
+			totals = append(totals, new(big.Int))
+		}
+
+		res := &resources[len(resources)-1]
+		amount := helpers.QNoahStr2Noah(row.Amount)
+		switch row.Role {
+		case RoleValidator:
+			res.Validator = amount
+		case RoleDelegator:
+			res.Delegator = amount
+		case RoleDAO:
+			res.DAO = amount
+		case RoleDevelopers:
+			res.Developers = amount
+		}
+
+		if value, ok := new(big.Int).SetString(row.Amount, 10); ok {
+			total := totals[len(totals)-1]
+			total.Add(total, value)
+			res.Total = helpers.QNoahStr2Noah(total.String())
+		}
+	}
+
+	return resources
+}
@@ -0,0 +1,159 @@
+package rich_list
+
+import (
+	"github.com/go-pg/pg"
+	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
+)
+
+type Repository struct {
+	db             *pg.DB
+	baseCoinSymbol string
+}
+
+func NewRepository(db *pg.DB, baseCoinSymbol string) *Repository {
+	return &Repository{
+		db:             db,
+		baseCoinSymbol: baseCoinSymbol,
+	}
+}
+
+// Ranked holdings of address in qNoah, custom coins are valued by their price in the base coin
+type Entry struct {
+	tableName struct{} `sql:"rich_list"`
+	Rank      uint64
+	AddressID uint64
+	Address   string
+	Balance   string
+	Stake     string
+	Total     string
+}
+
+// Ranked holdings of address in coin, stakes are in the coin of stake
+type CoinEntry struct {
+	tableName struct{} `sql:"coin_rich_list"`
+	CoinID    uint64
+	Rank      uint64
+	AddressID uint64
+	Address   string
+	Balance   string
+	Stake     string
+	Total     string
+}
+
+// Rankings are materialized views refreshed on schedule as ranking all addresses per request is too slow.
+// Unique indexes allow to refresh the views without blocking reads.
+var migrations = []string{`
+	CREATE MATERIALIZED VIEW IF NOT EXISTS rich_list AS
+	SELECT ROW_NUMBER() OVER (ORDER BY h.balance + h.stake DESC, h.address_id) AS rank,
+		h.address_id, a.address, h.balance, h.stake, h.balance + h.stake AS total
+	FROM (
+		SELECT u.address_id, SUM(u.balance) AS balance, SUM(u.stake) AS stake
+		FROM (
+			SELECT b.address_id,
+				CASE WHEN c.symbol = ?0 THEN b.value ELSE FLOOR(b.value * c.price::numeric / 1000000000000000000) END AS balance,
+				0 AS stake
+			FROM balances AS b INNER JOIN coins AS c ON c.id = b.coin_id
+			UNION ALL
+			SELECT s.owner_address_id, 0, s.noah_value FROM stakes AS s
+		) AS u
+		GROUP BY u.address_id
+	) AS h
+	INNER JOIN addresses AS a ON a.id = h.address_id
+	WHERE h.balance + h.stake > 0`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS rich_list_address_id_index ON rich_list (address_id)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS rich_list_rank_index ON rich_list (rank)`, `
+	CREATE MATERIALIZED VIEW IF NOT EXISTS coin_rich_list AS
+	SELECT h.coin_id, ROW_NUMBER() OVER (PARTITION BY h.coin_id ORDER BY h.balance + h.stake DESC, h.address_id) AS rank,
+		h.address_id, a.address, h.balance, h.stake, h.balance + h.stake AS total
+	FROM (
+		SELECT u.coin_id, u.address_id, SUM(u.balance) AS balance, SUM(u.stake) AS stake
+		FROM (
+			SELECT b.coin_id, b.address_id, b.value AS balance, 0 AS stake FROM balances AS b
+			UNION ALL
+			SELECT s.coin_id, s.owner_address_id, 0, s.value FROM stakes AS s
+		) AS u
+		GROUP BY u.coin_id, u.address_id
+	) AS h
+	INNER JOIN addresses AS a ON a.id = h.address_id
+	WHERE h.balance + h.stake > 0`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS coin_rich_list_address_id_index ON coin_rich_list (coin_id, address_id)`,
+	`CREATE UNIQUE INDEX IF NOT EXISTS coin_rich_list_rank_index ON coin_rich_list (coin_id, rank)`,
+}
+
+// Create rankings, the first creation computes them
+func (repository Repository) Migrate() error {
+	for _, migration := range migrations {
+		if _, err := repository.db.Exec(migration, repository.baseCoinSymbol); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Recompute rankings by the current balances and stakes
+func (repository Repository) Refresh() error {
+	for _, view := range []string{"rich_list", "coin_rich_list"} {
+		if _, err := repository.db.Exec("REFRESH MATERIALIZED VIEW CONCURRENTLY " + view); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Get paginated rich list ordered by rank
+func (repository Repository) GetPaginated(pagination *tools.Pagination) ([]Entry, error) {
+	var entries []Entry
+	var err error
+
+	pagination.Total, err = repository.db.Model(&entries).
+		Apply(pagination.Filter).
+		Order("rank").
+		SelectAndCount()
+
+	return entries, err
+}
+
+// Get rich list entry of address
+func (repository Repository) GetByAddress(address string) (*Entry, error) {
+	var entry Entry
+
+	err := repository.db.Model(&entry).
+		Where("address_id = (SELECT id FROM addresses WHERE address = ?)", address).
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
+
+// Get paginated rich list of coin ordered by rank
+func (repository Repository) GetPaginatedByCoin(symbol string, pagination *tools.Pagination) ([]CoinEntry, error) {
+	var entries []CoinEntry
+	var err error
+
+	pagination.Total, err = repository.db.Model(&entries).
+		Where("coin_id = (SELECT id FROM coins WHERE symbol = ?)", symbol).
+		Apply(pagination.Filter).
+		Order("rank").
+		SelectAndCount()
+
+	return entries, err
+}
+
+// Get rich list entry of address in coin
+func (repository Repository) GetByCoinAndAddress(symbol string, address string) (*CoinEntry, error) {
+	var entry CoinEntry
+
+	err := repository.db.Model(&entry).
+		Where("coin_id = (SELECT id FROM coins WHERE symbol = ?)", symbol).
+		Where("address_id = (SELECT id FROM addresses WHERE address = ?)", address).
+		Select()
+	if err != nil {
+		return nil, err
+	}
+
+	return &entry, nil
+}
@@ -0,0 +1,56 @@
+package rich_list
+
+import (
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/market"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+)
+
+type Resource struct {
+	Rank      uint64  `json:"rank"`
+	Address   string  `json:"address"`
+	Balance   string  `json:"balance"`
+	Stake     string  `json:"stake"`
+	Total     string  `json:"total"`
+	FiatTotal *string `json:"fiat_total"`
+}
+
+func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	entry := model.(Entry)
+
+	res := Resource{
+		Rank:    entry.Rank,
+		Address: (&models.Address{Address: entry.Address}).GetAddress(),
+		Balance: helpers.QNoahStr2Noah(entry.Balance),
+		Stake:   helpers.QNoahStr2Noah(entry.Stake),
+		Total:   helpers.QNoahStr2Noah(entry.Total),
+	}
+
+	if len(params) > 0 {
+		rates := params[0].(market.Rates)
+		res.FiatTotal = rates.CoinValue(&models.Coin{Symbol: rates.BaseCoin}, entry.Total)
+	}
+
+	return res
+}
+
+type CoinResource struct {
+	Rank    uint64 `json:"rank"`
+	Address string `json:"address"`
+	Balance string `json:"balance"`
+	Stake   string `json:"stake"`
+	Total   string `json:"total"`
+}
+
+func (CoinResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	entry := model.(CoinEntry)
+
+	return CoinResource{
+		Rank:    entry.Rank,
+		Address: (&models.Address{Address: entry.Address}).GetAddress(),
+		Balance: helpers.QNoahStr2Noah(entry.Balance),
+		Stake:   helpers.QNoahStr2Noah(entry.Stake),
+		Total:   helpers.QNoahStr2Noah(entry.Total),
+	}
+}
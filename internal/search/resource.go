@@ -0,0 +1,44 @@
+package search
+
+import (
+	"strconv"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+)
+
+// Types of search matches
+const (
+	TypeAddress            = "address"
+	TypeTransaction        = "transaction"
+	TypeInvalidTransaction = "invalid_transaction"
+	TypeBlock              = "block"
+	TypeValidator          = "validator"
+	TypeCoin               = "coin"
+)
+
+type Resource struct {
+	Type    string  `json:"type"`
+	Id      string  `json:"id"` // address, hash, height, public key or symbol to request the match by
+	Name    *string `json:"name"`
+	IconUrl *string `json:"icon_url"`
+}
+
+func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	switch match := model.(type) {
+	case models.Address:
+		return Resource{Type: TypeAddress, Id: match.GetAddress()}
+	case models.Transaction:
+		return Resource{Type: TypeTransaction, Id: match.GetHash()}
+	case models.InvalidTransaction:
+		return Resource{Type: TypeInvalidTransaction, Id: match.GetHash()}
+	case models.Block:
+		return Resource{Type: TypeBlock, Id: strconv.FormatUint(match.ID, 10)}
+	case models.Validator:
+		return Resource{Type: TypeValidator, Id: match.GetPublicKey(), Name: match.Name, IconUrl: match.IconUrl}
+	case models.Coin:
+		return Resource{Type: TypeCoin, Id: match.Symbol, Name: &match.Name, IconUrl: &match.IconURL}
+	}
+
+	return nil
+}
@@ -1,10 +1,13 @@
 package slash
 
 import (
+	"context"
+	"time"
+
 	"github.com/go-pg/pg"
 	"github.com/noah-blockchain/coinExplorer-tools/models"
 	"github.com/noah-blockchain/noah-explorer-api/internal/events"
-	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/export"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
 )
 
@@ -18,7 +21,7 @@ func NewRepository(db *pg.DB) *Repository {
 	}
 }
 
-func (repository Repository) GetPaginatedByAddress(filter events.SelectFilter, pagination *tools.Pagination) []models.Slash {
+func (repository Repository) GetPaginatedByAddress(filter events.SelectFilter, pagination *tools.Pagination) ([]models.Slash, error) {
 	var slashes []models.Slash
 	var err error
 
@@ -30,7 +33,44 @@ func (repository Repository) GetPaginatedByAddress(filter events.SelectFilter, p
 		Order("block_id DESC").
 		SelectAndCount()
 
-	helpers.CheckErr(err)
+	return slashes, err
+}
+
+// Get paginated list of slashes of validator delegators
+func (repository Repository) GetPaginatedByValidator(filter events.ValidatorSelectFilter, pagination *tools.Pagination) ([]models.Slash, error) {
+	var slashes []models.Slash
+	var err error
+
+	pagination.Total, err = repository.db.Model(&slashes).
+		Column("Coin.symbol", "Address.address", "Validator.public_key", "Block.created_at").
+		Column("Validator.name", "Validator.description", "Validator.icon_url", "Validator.site_url").
+		Apply(filter.Filter).
+		Apply(pagination.Filter).
+		Order("block_id DESC").
+		SelectAndCount()
+
+	return slashes, err
+}
+
+// Slash row of export
+type ExportRow struct {
+	BlockID   uint64
+	CreatedAt time.Time
+	Coin      string
+	Amount    string
+	Validator string
+}
 
-	return slashes
+// Stream slashes of address from the latest
+func (repository Repository) ForEachByAddress(ctx context.Context, filter export.Filter, fn func(*ExportRow) error) error {
+	return repository.db.Model((*models.Slash)(nil)).
+		Context(ctx).
+		ColumnExpr("slash.block_id, block.created_at, coin.symbol AS coin, slash.amount, validator.public_key AS validator").
+		Join("INNER JOIN blocks AS block ON block.id = slash.block_id").
+		Join("INNER JOIN coins AS coin ON coin.id = slash.coin_id").
+		Join("INNER JOIN validators AS validator ON validator.id = slash.validator_id").
+		Where("slash.address_id = (SELECT id FROM addresses WHERE address = ?)", filter.Address).
+		Apply(filter.Range("slash.block_id", "block.created_at")).
+		Order("slash.block_id DESC", "slash.id DESC").
+		ForEach(fn)
 }
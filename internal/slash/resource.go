@@ -32,3 +32,24 @@ func (Resource) Transform(model resource.ItemInterface, params ...resource.Param
 		ValidatorMeta: new(validatorMeta.Resource).Transform(*slash.Validator),
 	}
 }
+
+// Flat row of slash for export
+type ExportResource struct {
+	BlockID   uint64 `json:"block"`
+	Timestamp string `json:"timestamp"`
+	Coin      string `json:"coin"`
+	Amount    string `json:"amount"`
+	Validator string `json:"validator"`
+}
+
+func (ExportResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	row := model.(ExportRow)
+
+	return ExportResource{
+		BlockID:   row.BlockID,
+		Timestamp: row.CreatedAt.Format(time.RFC3339),
+		Coin:      row.Coin,
+		Amount:    helpers.QNoahStr2Noah(row.Amount),
+		Validator: `Np` + row.Validator,
+	}
+}
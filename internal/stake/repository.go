@@ -3,7 +3,6 @@ package stake
 import (
 	"github.com/go-pg/pg"
 	"github.com/noah-blockchain/coinExplorer-tools/models"
-	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
 )
 
@@ -18,7 +17,7 @@ func NewRepository(db *pg.DB) *Repository {
 }
 
 // Get list of stakes by Noah address
-func (repository Repository) GetByAddress(address string) []*models.Stake {
+func (repository Repository) GetByAddress(address string) ([]*models.Stake, error) {
 	var stakes []*models.Stake
 
 	err := repository.db.Model(&stakes).
@@ -26,12 +25,11 @@ func (repository Repository) GetByAddress(address string) []*models.Stake {
 		Where("owner_address.address = ?", address).
 		Select()
 
-	helpers.CheckErr(err)
-	return stakes
+	return stakes, err
 }
 
 // Get paginated list of stakes by Noah address
-func (repository Repository) GetPaginatedByAddress(address string, pagination *tools.Pagination) []models.Stake {
+func (repository Repository) GetPaginatedByAddress(address string, pagination *tools.Pagination) ([]models.Stake, error) {
 	var stakes []models.Stake
 	var err error
 
@@ -46,8 +44,7 @@ func (repository Repository) GetPaginatedByAddress(address string, pagination *t
 		Apply(pagination.Filter).
 		SelectAndCount()
 
-	helpers.CheckErr(err)
-	return stakes
+	return stakes, err
 }
 
 // Get total delegated noah value
@@ -70,7 +67,7 @@ func (repository Repository) GetSumInNoahValueByAddress(address string) (string,
 }
 
 // Get paginated list of stakes by Noah address
-func (repository Repository) GetPaginatedStakeForCoin(coinSymbol string, pagination *tools.Pagination) []models.Stake {
+func (repository Repository) GetPaginatedStakeForCoin(coinSymbol string, pagination *tools.Pagination) ([]models.Stake, error) {
 	var stakes []models.Stake
 	var err error
 
@@ -83,12 +80,11 @@ func (repository Repository) GetPaginatedStakeForCoin(coinSymbol string, paginat
 		Apply(pagination.Filter).
 		SelectAndCount()
 
-	helpers.CheckErr(err)
-	return stakes
+	return stakes, err
 }
 
 // Get paginated list of delegators by validator pubKey
-func (repository Repository) GetPaginatedDelegatorsForValidator(pubKey string, pagination *tools.Pagination) []models.Stake {
+func (repository Repository) GetPaginatedDelegatorsForValidator(pubKey string, pagination *tools.Pagination) ([]models.Stake, error) {
 	var stakeDelegators []models.Stake
 	var err error
 
@@ -101,24 +97,5 @@ func (repository Repository) GetPaginatedDelegatorsForValidator(pubKey string, p
 		Apply(pagination.Filter).
 		SelectAndCount()
 
-	helpers.CheckErr(err)
-	return stakeDelegators
-}
-
-func (repository Repository) GetStakesForAddress(address string) (*[]models.Stake, error) {
-	var stakes []models.Stake
-	var err error
-
-	_, err = repository.db.Query(&stakes, `
-		SELECT s.noah_value, s.created_at, v.total_stake, v.commission, v.id, v.public_key, c.symbol
-			FROM public.stakes as s 
-			LEFT JOIN public.addresses as a on a.id = s.owner_address_id
-			LEFT JOIN public.coins as c on c.id = s.coin_id
-			LEFT JOIN public.validators as v on v.id = s.validator_id
-			WHERE a.address=? AND v.status=? AND v.commission < 100 AND v.total_stake IS NOT NULL;
-	`, helpers.RemoveNoahPrefix(address), models.ValidatorStatusReady)
-	if err != nil {
-		return nil, err
-	}
-	return &stakes, nil
+	return stakeDelegators, err
 }
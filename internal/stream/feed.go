@@ -0,0 +1,198 @@
+package stream
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/blocks"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
+)
+
+const (
+	BlockEvent       = "block"
+	TransactionEvent = "transaction"
+)
+
+type Event struct {
+	Name string
+	Data resource.Interface
+}
+
+type Subscription struct {
+	Events chan Event
+	filter Filter
+}
+
+// Feed watches the last block and publishes new blocks and transactions to subscribers
+type Feed struct {
+	blockRepository       blocks.Repository
+	transactionRepository transaction.Repository
+	subscriptions         map[*Subscription]struct{}
+	lastBlockId           uint64
+	closed                bool
+	mutex                 sync.Mutex
+}
+
+func NewFeed(blockRepository blocks.Repository, transactionRepository transaction.Repository) *Feed {
+	return &Feed{
+		blockRepository:       blockRepository,
+		transactionRepository: transactionRepository,
+		subscriptions:         make(map[*Subscription]struct{}),
+	}
+}
+
+// Subscribe returns nil if the feed is already closed
+func (feed *Feed) Subscribe(filter Filter) *Subscription {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	if feed.closed {
+		return nil
+	}
+
+	subscription := &Subscription{
+		Events: make(chan Event, config.StreamSubscriberBufferSize),
+		filter: filter,
+	}
+
+	feed.subscriptions[subscription] = struct{}{}
+
+	return subscription
+}
+
+func (feed *Feed) Unsubscribe(subscription *Subscription) {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	feed.remove(subscription)
+}
+
+// Close all subscriptions, streaming handlers finish when their channel is closed
+func (feed *Feed) Close() {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	feed.closed = true
+	for subscription := range feed.subscriptions {
+		feed.remove(subscription)
+	}
+}
+
+// Poll the last block until the context is done
+func (feed *Feed) Run(ctx context.Context) {
+	ticker := time.NewTicker(config.StreamPollPeriodInMs * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			feed.Close()
+			return
+		case <-ticker.C:
+			feed.poll()
+		}
+	}
+}
+
+func (feed *Feed) poll() {
+	// transformation of malformed data panics with typed error
+	defer func() {
+		if err := recover(); err != nil {
+			log.Printf("stream: failed to publish new blocks: %v", err)
+		}
+	}()
+
+	if err := feed.fetch(); err != nil {
+		log.Printf("stream: failed to fetch new blocks: %s", err)
+	}
+}
+
+func (feed *Feed) fetch() error {
+	lastBlock, err := feed.blockRepository.GetLastBlock()
+	if err != nil {
+		return err
+	}
+
+	if feed.lastBlockId == 0 || lastBlock.ID <= feed.lastBlockId {
+		feed.lastBlockId = lastBlock.ID
+		return nil
+	}
+
+	toBlockId := lastBlock.ID
+	if toBlockId-feed.lastBlockId > config.StreamMaxBlocksPerPoll {
+		toBlockId = feed.lastBlockId + config.StreamMaxBlocksPerPoll
+	}
+
+	if feed.hasSubscriptions() {
+		newBlocks, err := feed.blockRepository.GetAfterId(feed.lastBlockId, toBlockId)
+		if err != nil {
+			return err
+		}
+
+		txs, err := feed.transactionRepository.GetTxsByBlocksRange(feed.lastBlockId, toBlockId)
+		if err != nil {
+			return err
+		}
+
+		feed.lastBlockId = toBlockId
+		feed.publish(newBlocks, txs)
+		return nil
+	}
+
+	feed.lastBlockId = toBlockId
+	return nil
+}
+
+// Publish blocks and their transactions in the chain order
+func (feed *Feed) publish(newBlocks []models.Block, txs []models.Transaction) {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	txIndex := 0
+	for _, block := range newBlocks {
+		feed.broadcast(Event{BlockEvent, new(blocks.Resource).Transform(block)}, func(filter Filter) bool {
+			return filter.MatchBlock(block)
+		})
+
+		for ; txIndex < len(txs) && txs[txIndex].BlockID <= block.ID; txIndex++ {
+			tx := txs[txIndex]
+			feed.broadcast(Event{TransactionEvent, new(transaction.Resource).Transform(tx)}, func(filter Filter) bool {
+				return filter.MatchTransaction(tx)
+			})
+		}
+	}
+}
+
+func (feed *Feed) broadcast(event Event, match func(filter Filter) bool) {
+	for subscription := range feed.subscriptions {
+		if !match(subscription.filter) {
+			continue
+		}
+
+		select {
+		case subscription.Events <- event:
+		default:
+			// drop subscribers which do not keep up with the chain
+			feed.remove(subscription)
+		}
+	}
+}
+
+func (feed *Feed) hasSubscriptions() bool {
+	feed.mutex.Lock()
+	defer feed.mutex.Unlock()
+
+	return len(feed.subscriptions) != 0
+}
+
+func (feed *Feed) remove(subscription *Subscription) {
+	if _, ok := feed.subscriptions[subscription]; ok {
+		delete(feed.subscriptions, subscription)
+		close(subscription.Events)
+	}
+}
@@ -0,0 +1,152 @@
+package stream
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+)
+
+// Subscription filter, empty filter receives all blocks and transactions
+type Filter struct {
+	Addresses  []string
+	Coins      []string
+	Validators []string
+}
+
+// Fields of the transaction data which may refer to addresses, coins or validators
+type txDataRefs struct {
+	To         string `json:"to"`
+	Address    string `json:"address"`
+	PubKey     string `json:"pub_key"`
+	Coin       string `json:"coin"`
+	CoinToBuy  string `json:"coin_to_buy"`
+	CoinToSell string `json:"coin_to_sell"`
+	List       []struct {
+		To   string `json:"to"`
+		Coin string `json:"coin"`
+	} `json:"list"`
+}
+
+func NewFilter(addresses []string, coins []string, validators []string) Filter {
+	filter := Filter{
+		Addresses:  make([]string, len(addresses)),
+		Coins:      make([]string, len(coins)),
+		Validators: make([]string, len(validators)),
+	}
+
+	for i, address := range addresses {
+		filter.Addresses[i] = normalizeAddress(address)
+	}
+
+	for i, coin := range coins {
+		filter.Coins[i] = strings.ToUpper(coin)
+	}
+
+	for i, publicKey := range validators {
+		filter.Validators[i] = normalizePublicKey(publicKey)
+	}
+
+	return filter
+}
+
+func (f Filter) IsEmpty() bool {
+	return len(f.Addresses) == 0 && len(f.Coins) == 0 && len(f.Validators) == 0
+}
+
+// Blocks are sent to subscribers without filters or to validator subscribers of the block validators
+func (f Filter) MatchBlock(block models.Block) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	for _, blockValidator := range block.BlockValidators {
+		if f.hasValidator(blockValidator.Validator.PublicKey) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f Filter) MatchTransaction(tx models.Transaction) bool {
+	if f.IsEmpty() {
+		return true
+	}
+
+	if tx.FromAddress != nil && f.hasAddress(tx.FromAddress.Address) {
+		return true
+	}
+
+	if tx.GasCoin != nil && f.hasCoin(tx.GasCoin.Symbol) {
+		return true
+	}
+
+	for _, output := range tx.TxOutputs {
+		if output.ToAddress != nil && f.hasAddress(output.ToAddress.Address) {
+			return true
+		}
+
+		if output.Coin != nil && f.hasCoin(output.Coin.Symbol) {
+			return true
+		}
+	}
+
+	for _, validator := range tx.Validators {
+		if f.hasValidator(validator.PublicKey) {
+			return true
+		}
+	}
+
+	var refs txDataRefs
+	if err := json.Unmarshal(tx.Data, &refs); err != nil {
+		return false
+	}
+
+	if f.hasAddress(refs.To) || f.hasAddress(refs.Address) || f.hasValidator(refs.PubKey) {
+		return true
+	}
+
+	if f.hasCoin(refs.Coin) || f.hasCoin(refs.CoinToBuy) || f.hasCoin(refs.CoinToSell) {
+		return true
+	}
+
+	for _, item := range refs.List {
+		if f.hasAddress(item.To) || f.hasCoin(item.Coin) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f Filter) hasAddress(address string) bool {
+	return address != "" && contains(f.Addresses, normalizeAddress(address))
+}
+
+func (f Filter) hasCoin(symbol string) bool {
+	return symbol != "" && contains(f.Coins, strings.ToUpper(symbol))
+}
+
+func (f Filter) hasValidator(publicKey string) bool {
+	return publicKey != "" && contains(f.Validators, normalizePublicKey(publicKey))
+}
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Addresses and public keys are compared in lower case without Noah prefixes
+func normalizeAddress(address string) string {
+	return strings.TrimPrefix(strings.ToLower(address), "noahx")
+}
+
+func normalizePublicKey(publicKey string) string {
+	return strings.TrimPrefix(strings.ToLower(publicKey), "np")
+}
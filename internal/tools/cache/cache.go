@@ -1,12 +1,19 @@
 package cache
 
 import (
+	"context"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
 )
 
+// atomic counters go first to be 64-bit aligned on 32-bit platforms
 type ExplorerCache struct {
-	items *sync.Map
+	hits   uint64
+	misses uint64
+	items  *sync.Map
 }
 
 // cache constructor
@@ -24,17 +31,24 @@ func (c *ExplorerCache) newCacheItem(value interface{}, ttl time.Duration) *Item
 	return &Item{value: value, ttl: &end}
 }
 
-// get or store value from cache
-func (c *ExplorerCache) Get(key interface{}, callback func() interface{}, ttl time.Duration) interface{} {
+// get or store value from cache, values are not stored if callback fails
+func (c *ExplorerCache) Get(key interface{}, callback func() (interface{}, error), ttl time.Duration) (interface{}, error) {
 	v, ok := c.items.Load(key)
 	if ok {
 		item := v.(*Item)
 		if !item.IsExpired() {
-			return item.value
+			atomic.AddUint64(&c.hits, 1)
+			return item.value, nil
 		}
 	}
 
-	return c.Store(key, callback(), ttl)
+	atomic.AddUint64(&c.misses, 1)
+	value, err := callback()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Store(key, value, ttl), nil
 }
 
 // save value to cache
@@ -43,6 +57,27 @@ func (c *ExplorerCache) Store(key interface{}, value interface{}, ttl time.Durat
 	return value
 }
 
+// count of stored items including expired ones
+func (c *ExplorerCache) Len() int {
+	count := 0
+	c.items.Range(func(key, value interface{}) bool {
+		count++
+		return true
+	})
+
+	return count
+}
+
+// count of reads returned from cache
+func (c *ExplorerCache) Hits() uint64 {
+	return atomic.LoadUint64(&c.hits)
+}
+
+// count of reads calling the callback
+func (c *ExplorerCache) Misses() uint64 {
+	return atomic.LoadUint64(&c.misses)
+}
+
 // loop for checking items expiration
 func (c *ExplorerCache) ExpirationCheck() {
 	c.items.Range(func(key, value interface{}) bool {
@@ -55,6 +90,21 @@ func (c *ExplorerCache) ExpirationCheck() {
 	})
 }
 
+// remove expired items on schedule until the context is done
+func (c *ExplorerCache) RunExpirationCheck(ctx context.Context) {
+	ticker := time.NewTicker(config.CacheExpirationCheckPeriodInSec * time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.ExpirationCheck()
+		}
+	}
+}
+
 // set new last block id
 //func (c *ExplorerCache) SetBlockId(id uint64) {
 //	c.lastBlockId = id
@@ -0,0 +1,51 @@
+package tools
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+)
+
+var errInvalidCursor = errors.New("invalid pagination cursor")
+
+// Encode row id into opaque pagination cursor
+func EncodeCursor(id uint64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatUint(id, 10)))
+}
+
+// Decode opaque pagination cursor into row id
+func DecodeCursor(cursor string) (uint64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+
+	id, err := strconv.ParseUint(string(raw), 10, 64)
+	if err != nil || id == 0 {
+		return 0, errInvalidCursor
+	}
+
+	return id, nil
+}
+
+// Cut rows ordered by blocks from the latest to the limit without splitting rows of one block
+// between pages, returns the length of the page and the block of its last row as the next cursor
+func PageByBlocks(blocks []uint64, limit int) (int, *uint64) {
+	if len(blocks) <= limit {
+		return len(blocks), nil
+	}
+
+	end := limit
+	for end > 0 && blocks[end-1] == blocks[limit] {
+		end--
+	}
+
+	// the whole page is one block
+	if end == 0 {
+		for end = limit; end < len(blocks) && blocks[end] == blocks[0]; end++ {
+		}
+	}
+
+	cursor := blocks[end-1]
+	return end, &cursor
+}
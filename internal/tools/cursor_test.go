@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, id := range []uint64{1, 20, 1234567890, 18446744073709551615} {
+		decoded, err := DecodeCursor(EncodeCursor(id))
+		if err != nil {
+			t.Fatalf("Cursor decoding failed for %d: %s", id, err)
+		}
+
+		if decoded != id {
+			t.Fatalf("Cursor round trip failed. Expected %d, got %d", id, decoded)
+		}
+	}
+}
+
+func TestDecodeInvalidCursor(t *testing.T) {
+	for _, cursor := range []string{"", "!!!", EncodeCursor(0), "YWJj"} {
+		if _, err := DecodeCursor(cursor); err == nil {
+			t.Fatalf("Cursor %q expected to be invalid", cursor)
+		}
+	}
+}
+
+func TestPageByBlocksKeepsBlockRowsTogether(t *testing.T) {
+	end, cursor := PageByBlocks([]uint64{10, 9, 9, 8}, 2)
+	if end != 1 || cursor == nil || *cursor != 10 {
+		t.Fatalf("expected page of block 10, got %d rows and cursor %v", end, cursor)
+	}
+
+	end, cursor = PageByBlocks([]uint64{9, 9, 9, 8}, 2)
+	if end != 3 || cursor == nil || *cursor != 9 {
+		t.Fatalf("expected page of block 9, got %d rows and cursor %v", end, cursor)
+	}
+
+	end, cursor = PageByBlocks([]uint64{10, 9}, 2)
+	if end != 2 || cursor != nil {
+		t.Fatalf("expected the last page, got %d rows and cursor %v", end, cursor)
+	}
+}
@@ -5,3 +5,14 @@ import "github.com/go-pg/pg/orm"
 type Filter interface {
 	Filter(q *orm.Query) (*orm.Query, error)
 }
+
+// Filters applied one after another
+type Filters []Filter
+
+func (filters Filters) Filter(q *orm.Query) (*orm.Query, error) {
+	for _, filter := range filters {
+		q = q.Apply(filter.Filter)
+	}
+
+	return q, nil
+}
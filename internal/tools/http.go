@@ -2,8 +2,10 @@ package tools
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"time"
 )
 
 type HttpClient struct {
@@ -14,12 +16,16 @@ type HttpClient struct {
 func NewHttpClient(host string) *HttpClient {
 	return &HttpClient{
 		host:   host,
-		client: &http.Client{},
+		client: &http.Client{Timeout: 30 * time.Second},
 	}
 }
 
 func (c *HttpClient) Get(url string, response interface{}) error {
 	req, err := http.NewRequest("GET", c.host+"/"+url, nil)
+	if err != nil {
+		return err
+	}
+
 	resp, err := c.client.Do(req)
 	if err != nil {
 		return err
@@ -27,6 +33,10 @@ func (c *HttpClient) Get(url string, response interface{}) error {
 
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s of %s", resp.Status, req.URL)
+	}
+
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		return err
@@ -16,10 +16,20 @@ type Pagination struct {
 	Request    *http.Request
 	RequestURL string
 	Total      int
+	Keyset     bool    // keyset (cursor) mode instead of page offsets
+	Cursor     *uint64 // id of the last row from the previous keyset page
+	NextCursor *uint64 // id of the last row of the current keyset page
 }
 
 const DefaultLimit = 20
 
+// Query parameters which switch pagination to keyset mode.
+// "cursor" is an opaque token from links.next, "after" is a plain row id.
+const (
+	CursorParam = "cursor"
+	AfterParam  = "after"
+)
+
 func NewPagination(request *http.Request) Pagination {
 	values := urlvalues.Values(request.URL.Query())
 	values.SetDefault("limit", strconv.Itoa(DefaultLimit))
@@ -36,11 +46,53 @@ func NewPagination(request *http.Request) Pagination {
 	}
 }
 
+// Pagination which switches to keyset mode when cursor or after parameter is passed.
+// Keyset pages skip the total count, so only use it with repositories supporting KeysetFilter.
+func NewCursorPagination(request *http.Request) Pagination {
+	pagination := NewPagination(request)
+
+	query := request.URL.Query()
+	if _, ok := query[CursorParam]; ok {
+		pagination.Keyset = true
+		if id, err := DecodeCursor(query.Get(CursorParam)); err == nil {
+			pagination.Cursor = &id
+		}
+	} else if _, ok := query[AfterParam]; ok {
+		pagination.Keyset = true
+		if id, err := strconv.ParseUint(query.Get(AfterParam), 10, 64); err == nil && id > 0 {
+			pagination.Cursor = &id
+		}
+	}
+
+	return pagination
+}
+
 func (pagination Pagination) Filter(query *orm.Query) (*orm.Query, error) {
 	return pagination.Pager.Pagination(query)
 }
 
+// Keyset filter for rows ordered by the given column in descending order.
+// Selects one extra row to find out whether the next page exists.
+func (pagination Pagination) KeysetFilter(column string) func(query *orm.Query) (*orm.Query, error) {
+	return func(query *orm.Query) (*orm.Query, error) {
+		if pagination.Cursor != nil {
+			query = query.Where(column+" < ?", *pagination.Cursor)
+		}
+
+		return query.Limit(pagination.GetPerPage() + 1), nil
+	}
+}
+
+// Remember id of the last returned row as the cursor of the next keyset page
+func (pagination *Pagination) SetNextCursor(id uint64) {
+	pagination.NextCursor = &id
+}
+
 func (pagination Pagination) GetNextPageLink() *string {
+	if pagination.Keyset {
+		return pagination.getNextCursorLink()
+	}
+
 	if pagination.GetLastPage() == pagination.GetCurrentPage() {
 		return nil
 	}
@@ -54,6 +106,10 @@ func (pagination Pagination) GetNextPageLink() *string {
 }
 
 func (pagination Pagination) GetLastPageLink() *string {
+	if pagination.Keyset {
+		return nil
+	}
+
 	lastPage := strconv.Itoa(pagination.GetLastPage())
 	query := pagination.Request.URL.Query()
 	query.Set("page", lastPage)
@@ -63,7 +119,7 @@ func (pagination Pagination) GetLastPageLink() *string {
 }
 
 func (pagination Pagination) GetPrevPageLink() *string {
-	if pagination.GetCurrentPage() == 1 {
+	if pagination.Keyset || pagination.GetCurrentPage() == 1 {
 		return nil
 	}
 
@@ -77,7 +133,35 @@ func (pagination Pagination) GetPrevPageLink() *string {
 
 func (pagination Pagination) GetFirstPageLink() *string {
 	query := pagination.Request.URL.Query()
-	query.Set("page", "1")
+	if pagination.Keyset {
+		query.Del(AfterParam)
+		query.Set(CursorParam, "")
+	} else {
+		query.Set("page", "1")
+	}
+
+	link := fmt.Sprintf("%s?%s", pagination.RequestURL, query.Encode())
+	return &link
+}
+
+func (pagination Pagination) GetNextCursor() *string {
+	if pagination.NextCursor == nil {
+		return nil
+	}
+
+	cursor := EncodeCursor(*pagination.NextCursor)
+	return &cursor
+}
+
+func (pagination Pagination) getNextCursorLink() *string {
+	cursor := pagination.GetNextCursor()
+	if cursor == nil {
+		return nil
+	}
+
+	query := pagination.Request.URL.Query()
+	query.Del(AfterParam)
+	query.Set(CursorParam, *cursor)
 
 	link := fmt.Sprintf("%s?%s", pagination.RequestURL, query.Encode())
 	return &link
@@ -88,6 +172,10 @@ func (pagination Pagination) GetPath() string {
 }
 
 func (pagination Pagination) GetLastPage() int {
+	if pagination.Keyset {
+		return 0
+	}
+
 	return int(math.Ceil(float64(pagination.Total) / float64(pagination.Pager.Limit)))
 }
 
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 
 	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
 	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
 	"github.com/noah-blockchain/noah-go-node/core/check"
@@ -26,22 +27,34 @@ type CheckData struct {
 func (RedeemCheck) Transform(txData resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
 	data := txData.(*models.RedeemCheckTxData)
 
+	// resources can not return errors, the panic is mapped to response by errors middleware
+	checkData, err := TransformCheckData(data.RawCheck)
+	if err != nil {
+		panic(err)
+	}
+
 	return RedeemCheck{
 		RawCheck: data.RawCheck,
 		Proof:    data.Proof,
-		Check:    TransformCheckData(data.RawCheck),
+		Check:    checkData,
 	}
 }
 
-func TransformCheckData(raw string) CheckData {
+func TransformCheckData(raw string) (CheckData, error) {
 	decoded, err := base64.StdEncoding.DecodeString(raw)
-	helpers.CheckErr(err)
+	if err != nil {
+		return CheckData{}, errors.NewMalformedData("Invalid check encoding", err)
+	}
 
 	data, err := check.DecodeFromBytes(decoded)
-	helpers.CheckErr(err)
+	if err != nil {
+		return CheckData{}, errors.NewMalformedData("Invalid check", err)
+	}
 
 	sender, err := data.Sender()
-	helpers.CheckErr(err)
+	if err != nil {
+		return CheckData{}, errors.NewMalformedData("Invalid check signature", err)
+	}
 
 	return CheckData{
 		Coin:     data.Coin.String(),
@@ -49,5 +62,5 @@ func TransformCheckData(raw string) CheckData {
 		Value:    helpers.QNoahStr2Noah(data.Value.String()),
 		Sender:   sender.String(),
 		DueBlock: data.DueBlock,
-	}
+	}, nil
 }
@@ -1,8 +1,12 @@
 package transaction
 
 import (
+	"strings"
+
+	"github.com/go-pg/pg"
 	"github.com/go-pg/pg/orm"
 	"github.com/noah-blockchain/noah-explorer-api/internal/blocks"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 )
 
 // TODO: replace string in StartBlock, EndBlock to int
@@ -52,3 +56,165 @@ func (f BlocksRangeSelectFilter) Filter(q *orm.Query) (*orm.Query, error) {
 
 	return q, nil
 }
+
+// Transaction directions relative to filtered addresses
+const (
+	DirectionIn  = "in"
+	DirectionOut = "out"
+)
+
+// Query parameters of transaction filter shared by transaction lists
+type FilterRequest struct {
+	Types     []uint8 `form:"type[]"    binding:"omitempty,max=14,dive,min=1,max=14"`
+	Coin      *string `form:"coin"      binding:"omitempty,max=10"`
+	GasCoin   *string `form:"gas_coin"  binding:"omitempty,max=10"`
+	MinValue  *string `form:"min_value" binding:"omitempty,numeric"`
+	MaxValue  *string `form:"max_value" binding:"omitempty,numeric"`
+	StartTime *string `form:"startTime" binding:"omitempty,timestamp"`
+	EndTime   *string `form:"endTime"   binding:"omitempty,timestamp"`
+}
+
+func (request FilterRequest) Filter() AttributesFilter {
+	filter := AttributesFilter{
+		Types:     request.Types,
+		Coin:      request.Coin,
+		GasCoin:   request.GasCoin,
+		StartTime: request.StartTime,
+		EndTime:   request.EndTime,
+	}
+
+	if request.MinValue != nil {
+		value := helpers.Noah2QNoahStr(*request.MinValue)
+		filter.MinValue = &value
+	}
+
+	if request.MaxValue != nil {
+		value := helpers.Noah2QNoahStr(*request.MaxValue)
+		filter.MaxValue = &value
+	}
+
+	return filter
+}
+
+// Filter by attributes of transaction, its outputs and data, empty fields are not applied.
+// Coin and value are matched by the same output, or by data of not send transactions.
+type AttributesFilter struct {
+	Types     []uint8
+	Coin      *string
+	GasCoin   *string
+	Direction *string
+	Addresses []string
+	MinValue  *string // in qNoah
+	MaxValue  *string // in qNoah
+	StartTime *string
+	EndTime   *string
+}
+
+// Coins and values of transaction data by transaction type
+const (
+	dataCoinSql  = "ARRAY[transaction.data->>'coin', transaction.data->>'coin_to_sell', transaction.data->>'coin_to_buy', transaction.data->>'symbol']"
+	dataValueSql = "COALESCE(transaction.data->>'value', transaction.data->>'value_to_sell', transaction.data->>'value_to_buy', transaction.data->>'stake', transaction.data->>'initial_amount')::numeric"
+)
+
+// Match only transactions incoming to or outgoing from the addresses without prefix
+func (f AttributesFilter) WithDirection(direction *string, addresses []string) AttributesFilter {
+	f.Direction, f.Addresses = direction, addresses
+	return f
+}
+
+func (f AttributesFilter) Filter(q *orm.Query) (*orm.Query, error) {
+	if len(f.Types) != 0 {
+		q = q.Where("transaction.type IN (?)", pg.In(f.Types))
+	}
+
+	if f.GasCoin != nil {
+		q = q.Where("transaction.gas_coin_id = (SELECT id FROM coins WHERE symbol = ?)", strings.ToUpper(*f.GasCoin))
+	}
+
+	if f.StartTime != nil {
+		q = q.Where("transaction.created_at >= ?", *f.StartTime)
+	}
+
+	if f.EndTime != nil {
+		q = q.Where("transaction.created_at <= ?", *f.EndTime)
+	}
+
+	if f.Direction != nil && len(f.Addresses) != 0 {
+		switch *f.Direction {
+		case DirectionIn:
+			// incoming transactions are sends with output to the address
+			return q.Where(f.outputsSql(true), f.outputsParams(true)...), nil
+		case DirectionOut:
+			q = q.Where("transaction.from_address_id IN (SELECT id FROM addresses WHERE address IN (?))", pg.In(f.Addresses))
+		}
+	}
+
+	if f.Coin == nil && f.MinValue == nil && f.MaxValue == nil {
+		return q, nil
+	}
+
+	return q.WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+		q = q.WhereOr(f.outputsSql(false), f.outputsParams(false)...)
+		q = q.WhereOrGroup(func(q *orm.Query) (*orm.Query, error) {
+			q = q.Where("NOT EXISTS (SELECT 1 FROM transaction_outputs AS o WHERE o.transaction_id = transaction.id)")
+			if f.Coin != nil {
+				q = q.Where("? = ANY("+dataCoinSql+")", strings.ToUpper(*f.Coin))
+			}
+
+			if f.MinValue != nil {
+				q = q.Where(dataValueSql+" >= ?", *f.MinValue)
+			}
+
+			if f.MaxValue != nil {
+				q = q.Where(dataValueSql+" <= ?", *f.MaxValue)
+			}
+
+			return q, nil
+		})
+
+		return q, nil
+	}), nil
+}
+
+// Condition of output matching coin and value, optionally sent to the addresses
+func (f AttributesFilter) outputsSql(toAddresses bool) string {
+	sql := "EXISTS (SELECT 1 FROM transaction_outputs AS o WHERE o.transaction_id = transaction.id"
+	if toAddresses {
+		sql += " AND o.to_address_id IN (SELECT id FROM addresses WHERE address IN (?))"
+	}
+
+	if f.Coin != nil {
+		sql += " AND o.coin_id = (SELECT id FROM coins WHERE symbol = ?)"
+	}
+
+	if f.MinValue != nil {
+		sql += " AND o.value >= ?"
+	}
+
+	if f.MaxValue != nil {
+		sql += " AND o.value <= ?"
+	}
+
+	return sql + ")"
+}
+
+func (f AttributesFilter) outputsParams(toAddresses bool) []interface{} {
+	var params []interface{}
+	if toAddresses {
+		params = append(params, pg.In(f.Addresses))
+	}
+
+	if f.Coin != nil {
+		params = append(params, strings.ToUpper(*f.Coin))
+	}
+
+	if f.MinValue != nil {
+		params = append(params, *f.MinValue)
+	}
+
+	if f.MaxValue != nil {
+		params = append(params, *f.MaxValue)
+	}
+
+	return params
+}
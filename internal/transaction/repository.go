@@ -1,11 +1,13 @@
 package transaction
 
 import (
+	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/go-pg/pg"
 	"github.com/noah-blockchain/coinExplorer-tools/models"
-	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/export"
 	"github.com/noah-blockchain/noah-explorer-api/internal/tools"
 )
 
@@ -20,11 +22,11 @@ func NewRepository(db *pg.DB) *Repository {
 }
 
 // Get paginated list of transactions by address filter
-func (repository Repository) GetPaginatedTxsByAddresses(addresses []string, filter BlocksRangeSelectFilter, pagination *tools.Pagination) []models.Transaction {
+func (repository Repository) GetPaginatedTxsByAddresses(addresses []string, filter tools.Filter, pagination *tools.Pagination) ([]models.Transaction, error) {
 	var transactions []models.Transaction
 	var err error
 
-	pagination.Total, err = repository.db.Model(&transactions).
+	query := repository.db.Model(&transactions).
 		Join("INNER JOIN index_transaction_by_address AS ind").
 		JoinOn("ind.transaction_id = transaction.id").
 		Join("INNER JOIN addresses AS a").
@@ -33,42 +35,81 @@ func (repository Repository) GetPaginatedTxsByAddresses(addresses []string, filt
 		Column("transaction.*", "FromAddress.address", "GasCoin.symbol").
 		Where("a.address IN (?)", pg.In(addresses)).
 		Apply(filter.Filter).
-		Apply(pagination.Filter).
-		Order("transaction.id DESC").
-		SelectAndCount()
+		Order("transaction.id DESC")
 
-	helpers.CheckErr(err)
+	if pagination.Keyset {
+		err = query.Apply(pagination.KeysetFilter("transaction.id")).Select()
+		if err != nil {
+			return nil, err
+		}
 
-	return transactions
+		return trimKeysetPage(transactions, pagination), nil
+	}
+
+	pagination.Total, err = query.Apply(pagination.Filter).SelectAndCount()
+
+	return transactions, err
 }
 
 // Get paginated list of transactions by select filter
-func (repository Repository) GetPaginatedTxsByFilter(filter tools.Filter, pagination *tools.Pagination) []models.Transaction {
+func (repository Repository) GetPaginatedTxsByFilter(filter tools.Filter, pagination *tools.Pagination) ([]models.Transaction, error) {
 	var transactions []models.Transaction
 	var err error
 
-	pagination.Total, err = repository.db.Model(&transactions).
+	query := repository.db.Model(&transactions).
 		Column("transaction.*", "FromAddress.address", "GasCoin.symbol").
 		Apply(filter.Filter).
-		Apply(pagination.Filter).
-		Order("transaction.id DESC").
-		SelectAndCount()
+		Order("transaction.id DESC")
+
+	if pagination.Keyset {
+		err = query.Apply(pagination.KeysetFilter("transaction.id")).Select()
+		if err != nil {
+			return nil, err
+		}
+
+		return trimKeysetPage(transactions, pagination), nil
+	}
+
+	pagination.Total, err = query.Apply(pagination.Filter).SelectAndCount()
+
+	return transactions, err
+}
 
-	helpers.CheckErr(err)
+// Cut the extra row selected by keyset filter and set cursor of the next page
+func trimKeysetPage(transactions []models.Transaction, pagination *tools.Pagination) []models.Transaction {
+	if len(transactions) > pagination.GetPerPage() {
+		transactions = transactions[:pagination.GetPerPage()]
+		pagination.SetNextCursor(transactions[len(transactions)-1].ID)
+	}
 
 	return transactions
 }
 
+// Get transactions of blocks in range (fromBlockId, toBlockId] with outputs and validators
+func (repository Repository) GetTxsByBlocksRange(fromBlockId uint64, toBlockId uint64) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	err := repository.db.Model(&transactions).
+		Column("transaction.*", "FromAddress.address", "GasCoin.symbol").
+		Column("TxOutputs", "TxOutputs.ToAddress", "TxOutputs.Coin", "Validators").
+		Where("transaction.block_id > ?", fromBlockId).
+		Where("transaction.block_id <= ?", toBlockId).
+		Order("transaction.id ASC").
+		Select()
+
+	return transactions, err
+}
+
 // Get transaction by hash
-func (repository Repository) GetTxByHash(hash string) *models.Transaction {
+func (repository Repository) GetTxByHash(hash string) (*models.Transaction, error) {
 	var transaction models.Transaction
 
 	err := repository.db.Model(&transaction).Column("FromAddress", "GasCoin.symbol").Where("hash = ?", hash).Select()
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	return &transaction
+	return &transaction, nil
 }
 
 type TxCountChartData struct {
@@ -77,7 +118,7 @@ type TxCountChartData struct {
 }
 
 // Get list of transactions counts filtered by created_at
-func (repository Repository) GetTxCountChartDataByFilter(filter tools.Filter) []TxCountChartData {
+func (repository Repository) GetTxCountChartDataByFilter(filter tools.Filter) ([]TxCountChartData, error) {
 	var tx models.Transaction
 	var data []TxCountChartData
 
@@ -86,13 +127,11 @@ func (repository Repository) GetTxCountChartDataByFilter(filter tools.Filter) []
 		Apply(filter.Filter).
 		Select(&data)
 
-	helpers.CheckErr(err)
-
-	return data
+	return data, err
 }
 
 // Get total transaction count
-func (repository Repository) GetTotalTransactionCount(startTime *string) int {
+func (repository Repository) GetTotalTransactionCount(startTime *string) (int, error) {
 	var tx models.Transaction
 
 	query := repository.db.Model(&tx)
@@ -100,10 +139,7 @@ func (repository Repository) GetTotalTransactionCount(startTime *string) int {
 		query = query.Column("Block._").Where("block.created_at >= ?", *startTime)
 	}
 
-	count, err := query.Count()
-	helpers.CheckErr(err)
-
-	return count
+	return query.Count()
 }
 
 type Tx24hData struct {
@@ -113,7 +149,7 @@ type Tx24hData struct {
 }
 
 // Get transactions data by last 24 hours
-func (repository Repository) Get24hTransactionsData() Tx24hData {
+func (repository Repository) Get24hTransactionsData() (Tx24hData, error) {
 	var tx models.Transaction
 	var data Tx24hData
 
@@ -123,12 +159,11 @@ func (repository Repository) Get24hTransactionsData() Tx24hData {
 		Where("block.created_at >= ?", time.Now().AddDate(0, 0, -1).Format(time.RFC3339)).
 		Select(&data)
 
-	helpers.CheckErr(err)
-	return data
+	return data, err
 }
 
 // Get paginated list of transactions by coin
-func (repository Repository) GetPaginatedTxsByCoin(coinSymbol string, pagination *tools.Pagination) []models.TransactionOutput {
+func (repository Repository) GetPaginatedTxsByCoin(coinSymbol string, filter tools.Filter, pagination *tools.Pagination) ([]models.TransactionOutput, error) {
 	var transactionOutputs []models.TransactionOutput
 	var err error
 
@@ -138,10 +173,49 @@ func (repository Repository) GetPaginatedTxsByCoin(coinSymbol string, pagination
 		Where("c.symbol=?", coinSymbol).
 		Column("Transaction", "transaction_output.value",
 			"Transaction.FromAddress", "Transaction.GasCoin").
+		Apply(filter.Filter).
 		Apply(pagination.Filter).
 		Order("transaction_output.id DESC").
 		SelectAndCount()
 
-	helpers.CheckErr(err)
-	return transactionOutputs
+	return transactionOutputs, err
+}
+
+// Transaction with one of its outputs, output fields are empty for transactions without outputs
+type Transfer struct {
+	ID          uint64
+	Hash        string
+	BlockID     uint64
+	CreatedAt   time.Time
+	Type        uint8
+	Gas         uint64
+	GasPrice    uint64
+	Data        json.RawMessage
+	Payload     []byte
+	FromAddress string
+	GasCoin     *string
+	ToAddress   *string
+	Coin        *string
+	Value       *string
+}
+
+// Stream transfers of address from the latest, outputs of multisend to other addresses are skipped
+func (repository Repository) ForEachTransferByAddress(ctx context.Context, filter export.Filter, fn func(*Transfer) error) error {
+	return repository.db.Model((*models.Transaction)(nil)).
+		Context(ctx).
+		ColumnExpr("transaction.id, transaction.hash, transaction.block_id, transaction.created_at, transaction.type").
+		ColumnExpr("transaction.gas, transaction.gas_price, transaction.data, transaction.payload").
+		ColumnExpr("fa.address AS from_address, gc.symbol AS gas_coin").
+		ColumnExpr("ta.address AS to_address, oc.symbol AS coin, o.value").
+		Join("INNER JOIN addresses AS a ON a.address = ?", filter.Address).
+		Join("INNER JOIN addresses AS fa ON fa.id = transaction.from_address_id").
+		Join("LEFT JOIN coins AS gc ON gc.id = transaction.gas_coin_id").
+		Join("LEFT JOIN transaction_outputs AS o ON o.transaction_id = transaction.id").
+		Join("LEFT JOIN addresses AS ta ON ta.id = o.to_address_id").
+		Join("LEFT JOIN coins AS oc ON oc.id = o.coin_id").
+		Where("transaction.id IN (SELECT transaction_id FROM index_transaction_by_address WHERE address_id = a.id)").
+		Where("o.id IS NULL OR transaction.from_address_id = a.id OR o.to_address_id = a.id").
+		Apply(filter.Range("transaction.block_id", "transaction.created_at")).
+		Order("transaction.id DESC", "o.id ASC").
+		ForEach(fn)
 }
@@ -3,10 +3,12 @@ package transaction
 import (
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"reflect"
 	"time"
 
 	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/errors"
 	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
 	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
 	"github.com/noah-blockchain/noah-explorer-api/internal/transaction/data_resources"
@@ -32,6 +34,12 @@ type Resource struct {
 func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
 	tx := model.(models.Transaction)
 
+	// resources can not return errors, the panic is mapped to response by errors middleware
+	data, err := TransformTxData(tx)
+	if err != nil {
+		panic(err)
+	}
+
 	res := Resource{
 		Txn:       tx.ID,
 		Hash:      tx.GetHash(),
@@ -42,7 +50,7 @@ func (Resource) Transform(model resource.ItemInterface, params ...resource.Param
 		Type:      tx.Type,
 		Payload:   base64.StdEncoding.EncodeToString(tx.Payload[:]),
 		From:      tx.FromAddress.GetAddress(),
-		Data:      TransformTxData(tx),
+		Data:      data,
 		Gas:       tx.Gas,
 		GasPrice:  tx.GasPrice,
 	}
@@ -83,14 +91,28 @@ var transformConfig = map[uint8]TransformTxConfig{
 	models.TxTypeSetCandidateOffline: {Model: new(models.SetCandidateTxData), Resource: data_resources.SetCandidate{}},
 }
 
-func TransformTxData(tx models.Transaction) resource.Interface {
-	config := transformConfig[tx.Type]
+// Resources of transaction data by transaction type
+func DataResources() map[uint8]resource.Interface {
+	resources := make(map[uint8]resource.Interface, len(transformConfig))
+	for txType, config := range transformConfig {
+		resources[txType] = config.Resource
+	}
+
+	return resources
+}
+
+func TransformTxData(tx models.Transaction) (resource.Interface, error) {
+	config, ok := transformConfig[tx.Type]
+	if !ok {
+		return nil, errors.NewMalformedData(fmt.Sprintf("Unknown type %d of transaction %d", tx.Type, tx.ID), nil)
+	}
 
 	val := reflect.New(reflect.TypeOf(config.Model).Elem()).Interface()
-	err := json.Unmarshal(tx.Data, val)
-	helpers.CheckErr(err)
+	if err := json.Unmarshal(tx.Data, val); err != nil {
+		return nil, errors.NewMalformedData(fmt.Sprintf("Invalid data of transaction %d", tx.ID), err)
+	}
 
-	return config.Resource.Transform(val, tx)
+	return config.Resource.Transform(val, tx), nil
 }
 
 type ResourceTransactionOutput struct {
@@ -126,7 +148,12 @@ func (ResourceTransactionOutput) Transform(model resource.ItemInterface, params
 	}
 
 	if txOutput.Transaction != nil {
-		res.Data = TransformTxData(*txOutput.Transaction)
+		data, err := TransformTxData(*txOutput.Transaction)
+		if err != nil {
+			panic(err)
+		}
+
+		res.Data = data
 	}
 
 	if txOutput.Transaction.GasCoin != nil {
@@ -142,3 +169,81 @@ func (ResourceTransactionOutput) Transform(model resource.ItemInterface, params
 
 	return res
 }
+
+// Flat row of transaction transfer for export
+type TransferResource struct {
+	Txn       uint64  `json:"txn"`
+	Hash      string  `json:"hash"`
+	Block     uint64  `json:"block"`
+	CreatedAt string  `json:"created_at"`
+	Type      uint8   `json:"type"`
+	From      string  `json:"from"`
+	To        *string `json:"to"`
+	Coin      *string `json:"coin"`
+	Value     *string `json:"value"`
+	Fee       string  `json:"fee"`
+	GasCoin   *string `json:"gas_coin"`
+	Payload   string  `json:"payload"`
+}
+
+// Coin and value fields of transaction data in order of priority
+var dataTransferFields = [][2]string{
+	{"coin", "value"},
+	{"coin_to_sell", "value_to_sell"},
+	{"coin_to_buy", "value_to_buy"},
+	{"coin", "stake"},
+	{"symbol", "initial_amount"},
+	{"coin_to_sell", ""},
+}
+
+func (TransferResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	transfer := model.(Transfer)
+
+	res := TransferResource{
+		Txn:       transfer.ID,
+		Hash:      `Nt` + transfer.Hash,
+		Block:     transfer.BlockID,
+		CreatedAt: transfer.CreatedAt.Format(time.RFC3339),
+		Type:      transfer.Type,
+		From:      `NOAHx` + transfer.FromAddress,
+		Coin:      transfer.Coin,
+		Fee:       helpers.Fee2Noah(transfer.Gas * transfer.GasPrice),
+		GasCoin:   transfer.GasCoin,
+		Payload:   base64.StdEncoding.EncodeToString(transfer.Payload),
+	}
+
+	if transfer.ToAddress != nil {
+		to := `NOAHx` + *transfer.ToAddress
+		res.To = &to
+	}
+
+	if transfer.Value != nil {
+		value := helpers.QNoahStr2Noah(*transfer.Value)
+		res.Value = &value
+		return res
+	}
+
+	// transactions without outputs are described by their data
+	var data map[string]interface{}
+	if err := json.Unmarshal(transfer.Data, &data); err != nil {
+		panic(errors.NewMalformedData(fmt.Sprintf("Invalid data of transaction %d", transfer.ID), err))
+	}
+
+	for _, fields := range dataTransferFields {
+		coin, hasCoin := data[fields[0]].(string)
+		value, hasValue := data[fields[1]].(string)
+		if !hasCoin || (fields[1] != "" && !hasValue) {
+			continue
+		}
+
+		res.Coin = &coin
+		if hasValue {
+			value = helpers.QNoahStr2Noah(value)
+			res.Value = &value
+		}
+
+		break
+	}
+
+	return res
+}
@@ -0,0 +1,81 @@
+package validator
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+)
+
+const precision = 100
+
+const daysInYear = 365
+const daysInMonth = 30
+
+// Expected delegator rewards of stake
+type Estimate struct {
+	Daily   *big.Float
+	Monthly *big.Float
+	Yearly  *big.Float
+}
+
+// Annual percentage yield of validator stake by delegator rewards of the period in days,
+// delegator rewards are received after validator commission
+func Apy(rewards string, totalStake string, days int) *string {
+	daily, ok := dailyRewardPerStake(rewards, totalStake, days)
+	if !ok {
+		return nil
+	}
+
+	apy := daily.Mul(daily, big.NewFloat(daysInYear*100)).Text('f', 2)
+	return &apy
+}
+
+// Estimate rewards of a new stake by delegator rewards of the period in days,
+// the stake is added to the total stake of validator
+func EstimateRewards(rewards string, totalStake string, days int, noahValue *big.Int) Estimate {
+	stake := helpers.NewFloat(0, precision).SetInt(noahValue)
+	total, ok := helpers.NewFloat(0, precision).SetString(totalStake)
+	if !ok {
+		total = helpers.NewFloat(0, precision)
+	}
+
+	daily := helpers.NewFloat(0, precision)
+	if perStake, ok := dailyRewardPerStake(rewards, total.Add(total, stake).Text('f', 0), days); ok {
+		daily.Mul(perStake, stake)
+	}
+
+	return Estimate{
+		Daily:   daily,
+		Monthly: helpers.NewFloat(0, precision).Mul(daily, big.NewFloat(daysInMonth)),
+		Yearly:  helpers.NewFloat(0, precision).Mul(daily, big.NewFloat(daysInYear)),
+	}
+}
+
+// Days of the apy period before the day, validators younger than the period are counted
+// since the day of creation
+func ApyDays(createdAt time.Time, today time.Time) int {
+	days := int(today.Sub(helpers.StartOfTheDay(createdAt)).Hours() / 24)
+	if createdAt.IsZero() || days > config.ValidatorApyPeriodInDays {
+		return config.ValidatorApyPeriodInDays
+	}
+
+	return days
+}
+
+// Average delegator reward of one unit of stake per day
+func dailyRewardPerStake(rewards string, totalStake string, days int) (*big.Float, bool) {
+	sum, ok := helpers.NewFloat(0, precision).SetString(rewards)
+	if !ok || days <= 0 {
+		return nil, false
+	}
+
+	total, ok := helpers.NewFloat(0, precision).SetString(totalStake)
+	if !ok || total.Sign() <= 0 {
+		return nil, false
+	}
+
+	sum.Quo(sum, total)
+	return sum.Quo(sum, big.NewFloat(float64(days))), true
+}
@@ -0,0 +1,49 @@
+package validator
+
+import (
+	"math/big"
+	"testing"
+	"time"
+)
+
+func TestApy(t *testing.T) {
+	// 30 of 1000 staked in 30 days is 1 per day, 36.5% per year
+	apy := Apy("30", "1000", 30)
+	if apy == nil || *apy != "36.50" {
+		t.Fatalf("expected apy 36.50, got %v", apy)
+	}
+
+	if Apy("30", "0", 30) != nil {
+		t.Fatalf("expected no apy without stake")
+	}
+}
+
+func TestEstimateRewards(t *testing.T) {
+	// the new stake of 1000 gets half of 60 rewards in 30 days
+	estimate := EstimateRewards("60", "1000", 30, big.NewInt(1000))
+
+	if estimate.Daily.Text('f', 0) != "1" {
+		t.Fatalf("expected daily 1, got %s", estimate.Daily.Text('f', 2))
+	}
+
+	if estimate.Monthly.Text('f', 0) != "30" || estimate.Yearly.Text('f', 0) != "365" {
+		t.Fatalf("unexpected monthly %s or yearly %s", estimate.Monthly.Text('f', 2), estimate.Yearly.Text('f', 2))
+	}
+}
+
+func TestApyDays(t *testing.T) {
+	today := time.Date(2019, 11, 30, 0, 0, 0, 0, time.UTC)
+
+	if days := ApyDays(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC), today); days != 30 {
+		t.Fatalf("expected the whole period of 30 days, got %d", days)
+	}
+
+	// the day of creation is counted
+	if days := ApyDays(time.Date(2019, 11, 20, 15, 0, 0, 0, time.UTC), today); days != 10 {
+		t.Fatalf("expected 10 days since creation, got %d", days)
+	}
+
+	if days := ApyDays(time.Time{}, today); days != 30 {
+		t.Fatalf("expected the whole period without creation time, got %d", days)
+	}
+}
@@ -20,7 +20,7 @@ func NewRepository(db *pg.DB) *Repository {
 	}
 }
 
-func (repository Repository) GetByPublicKey(publicKey string) *models.Validator {
+func (repository Repository) GetByPublicKey(publicKey string) (*models.Validator, error) {
 	var validator models.Validator
 
 	err := repository.db.Model(&validator).
@@ -29,13 +29,13 @@ func (repository Repository) GetByPublicKey(publicKey string) *models.Validator
 		Select()
 
 	if err != nil {
-		return nil
+		return nil, err
 	}
 
-	return &validator
+	return &validator, nil
 }
 
-func (repository Repository) GetTotalStakeByActiveValidators(ids []uint64) string {
+func (repository Repository) GetTotalStakeByActiveValidators(ids []uint64) (string, error) {
 	var total string
 
 	// get total stake of active validators
@@ -44,47 +44,65 @@ func (repository Repository) GetTotalStakeByActiveValidators(ids []uint64) strin
 		Where("id IN (?)", pg.In(ids)).
 		Select(&total)
 
-	helpers.CheckErr(err)
-	return total
+	return total, err
 }
 
-func (repository Repository) GetActiveValidatorIds() []uint64 {
+func (repository Repository) GetActiveValidatorIds() ([]uint64, error) {
 	var blockValidator models.BlockValidator
 	var ids []uint64
 
+	lastBlock, err := blocks.NewRepository(repository.db).GetLastBlock()
+	if err != nil {
+		return nil, err
+	}
+
 	// get active validators by last block
-	err := repository.db.Model(&blockValidator).
+	err = repository.db.Model(&blockValidator).
 		Column("validator_id").
-		Where("block_id = ?", blocks.NewRepository(repository.db).GetLastBlock().ID).
+		Where("block_id = ?", lastBlock.ID).
 		Select(&ids)
 
-	helpers.CheckErr(err)
-	return ids
+	return ids, err
 }
 
 // Get active candidates count
-func (repository Repository) GetActiveCandidatesCount() int {
+func (repository Repository) GetActiveCandidatesCount() (int, error) {
 	var validator models.Validator
 
-	count, err := repository.db.Model(&validator).
+	return repository.db.Model(&validator).
 		Where("status = ?", models.ValidatorStatusReady).
 		Count()
+}
 
-	helpers.CheckErr(err)
-	return count
+// Get total stake of online candidate at the position by total stake, nil if there are fewer candidates
+func (repository Repository) GetCandidateStakeAtPosition(position int) (*string, error) {
+	var stakes []string
+
+	err := repository.db.Model((*models.Validator)(nil)).
+		Column("total_stake").
+		Where("status = ?", models.ValidatorStatusReady).
+		Where("total_stake IS NOT NULL").
+		Order("total_stake DESC").
+		Offset(position - 1).
+		Limit(1).
+		Select(&stakes)
+	if err != nil || len(stakes) == 0 {
+		return nil, err
+	}
+
+	return &stakes[0], nil
 }
 
 // Get validators
-func (repository Repository) GetValidators() []models.Validator {
+func (repository Repository) GetValidators() ([]models.Validator, error) {
 	var validators []models.Validator
 
 	err := repository.db.Model(&validators).Select()
 
-	helpers.CheckErr(err)
-	return validators
+	return validators, err
 }
 
-func (repository Repository) GetValidatorsBySymbol(coinSymbol string, pagination *tools.Pagination) []models.Validator {
+func (repository Repository) GetValidatorsBySymbol(coinSymbol string, pagination *tools.Pagination) ([]models.Validator, error) {
 	var validators []models.Validator
 	var err error
 
@@ -99,11 +117,10 @@ func (repository Repository) GetValidatorsBySymbol(coinSymbol string, pagination
 		Apply(pagination.Filter).
 		SelectAndCount()
 
-	helpers.CheckErr(err)
-	return validators
+	return validators, err
 }
 
-func (repository Repository) GetValidatorsWithPagination(pagination *tools.Pagination, field *string, orderBy *string) []models.Validator {
+func (repository Repository) GetValidatorsWithPagination(pagination *tools.Pagination, field *string, orderBy *string) ([]models.Validator, error) {
 	var validators []models.Validator
 	var err error
 	fieldSql := "uptime"
@@ -123,6 +140,19 @@ func (repository Repository) GetValidatorsWithPagination(pagination *tools.Pagin
 
 	pagination.Total, err = query.SelectAndCount()
 
-	helpers.CheckErr(err)
-	return validators
+	return validators, err
+}
+
+// Get validators with name starting with prefix, case insensitive
+func (repository Repository) GetByNamePrefix(prefix string, limit int) ([]models.Validator, error) {
+	var validators []models.Validator
+
+	err := repository.db.Model(&validators).
+		Column("public_key", "name", "icon_url").
+		Where("name ILIKE ?", helpers.EscapeLike(prefix)+"%").
+		Order("name ASC").
+		Limit(limit).
+		Select()
+
+	return validators, err
 }
@@ -19,6 +19,7 @@ type Resource struct {
 	Commission      uint64                `json:"commission"`
 	Part            *string               `json:"part"`
 	Uptime          *float64              `json:"uptime"`
+	Apy             *string               `json:"apy"`
 	CountDelegators *uint64               `json:"count_delegators"`
 	DelegatorCount  *int                  `json:"delegator_count,omitempty"`
 	DelegatorList   *[]resource.Interface `json:"delegator_list,omitempty"`
@@ -28,6 +29,7 @@ type Resource struct {
 type Params struct {
 	TotalStake          string // total stake of current active validator ids (by last block)
 	ActiveValidatorsIDs []uint64
+	DelegatorRewards    map[uint64]string // delegator rewards by validator ids for the apy period
 }
 
 // Required extra params: object type of Params.
@@ -42,6 +44,7 @@ func (r Resource) Transform(model resource.ItemInterface, values ...resource.Par
 		Stake:           validatorStake,
 		Part:            part,
 		Uptime:          validator.Uptime,
+		Apy:             GetValidatorApy(validator, params.DelegatorRewards),
 		Meta:            new(meta.Resource).Transform(validator),
 		CountDelegators: validator.CountDelegators,
 		CreatedAt:       validator.CreatedAt.Format(time.RFC3339),
@@ -71,6 +74,20 @@ func GetValidatorPartAndStake(validator models.Validator, totalStake string, val
 	return part, stakeFull
 }
 
+// return annual percentage yield of validator stake
+func GetValidatorApy(validator models.Validator, delegatorRewards map[uint64]string) *string {
+	if validator.TotalStake == nil {
+		return nil
+	}
+
+	rewards, ok := delegatorRewards[validator.ID]
+	if !ok {
+		rewards = "0"
+	}
+
+	return Apy(rewards, *validator.TotalStake, ApyDays(validator.CreatedAt, helpers.StartOfTheDay(time.Now())))
+}
+
 // return list of delegators and count
 func (r Resource) getDelegatorsListAndCount(validator models.Validator) (*[]resource.Interface, *int) {
 	delegatorsCount := len(validator.Stakes)
@@ -104,6 +121,7 @@ type ResourceAggregator struct {
 	Stake           *string            `json:"stake"`
 	Part            *string            `json:"part"`
 	Uptime          *float64           `json:"uptime"`
+	Apy             *string            `json:"apy"`
 	Commission      uint64             `json:"commission"`
 	Status          *uint8             `json:"status"`
 	CreatedAt       string             `json:"created_at"`
@@ -115,3 +133,28 @@ func (ResourceAggregator) Transform(model resource.ItemInterface, params ...reso
 	validator := model.(ResourceAggregator)
 	return validator
 }
+
+type CalculatorResource struct {
+	Coin      string  `json:"coin"`
+	Amount    string  `json:"amount"`
+	NoahValue string  `json:"noah_value"`
+	Apy       *string `json:"apy"`
+	Daily     string  `json:"daily"`
+	Monthly   string  `json:"monthly"`
+	Yearly    string  `json:"yearly"`
+}
+
+func (CalculatorResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	return model.(CalculatorResource)
+}
+
+type ThresholdResource struct {
+	ValidatorsCount       int     `json:"validators_count"`
+	ActiveValidatorsCount int     `json:"active_validators_count"`
+	CandidatesCount       int     `json:"candidates_count"`
+	MinStake              *string `json:"min_stake"`
+}
+
+func (ThresholdResource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	return model.(ThresholdResource)
+}
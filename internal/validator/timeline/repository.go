@@ -0,0 +1,173 @@
+package timeline
+
+import (
+	"time"
+
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/core/config"
+)
+
+// Transaction types of validator lifecycle
+var TxTypes = []uint8{
+	models.TxTypeDeclareCandidacy,
+	models.TxTypeEditCandidate,
+	models.TxTypeSetCandidateOnline,
+	models.TxTypeSetCandidateOffline,
+	models.TxTypeDelegate,
+	models.TxTypeUnbound,
+}
+
+type Repository struct {
+	db *pg.DB
+}
+
+func NewRepository(db *pg.DB) *Repository {
+	return &Repository{
+		db: db,
+	}
+}
+
+// Slashes of validator delegators in coin at the block
+type Slash struct {
+	BlockID    uint64
+	CreatedAt  time.Time
+	Coin       string
+	Amount     string
+	Delegators uint64
+}
+
+// Entry to or exit from the validator set
+type SetChange struct {
+	BlockID   uint64
+	CreatedAt time.Time
+	Entered   bool
+}
+
+// Get the latest lifecycle transactions of validator before the block,
+// at least limit rows and all rows of the block of the last one
+func (repository Repository) GetTransactions(publicKey string, beforeBlock *uint64, limit int) ([]models.Transaction, error) {
+	var transactions []models.Transaction
+
+	filter := func(query *orm.Query) (*orm.Query, error) {
+		query = query.Join("INNER JOIN transaction_validator AS tv ON tv.transaction_id = transaction.id").
+			Where("tv.validator_id = (SELECT id FROM validators WHERE public_key = ?)", publicKey).
+			Where("transaction.type IN (?)", pg.In(TxTypes))
+
+		if beforeBlock != nil {
+			query = query.Where("transaction.block_id < ?", *beforeBlock)
+		}
+
+		return query, nil
+	}
+
+	lastBlock := repository.db.Model((*models.Transaction)(nil)).
+		Column("transaction.block_id").
+		Apply(filter).
+		Order("transaction.block_id DESC", "transaction.id DESC").
+		Offset(limit - 1).
+		Limit(1)
+
+	err := repository.db.Model(&transactions).
+		Column("transaction.*", "FromAddress.address", "GasCoin.symbol").
+		Apply(filter).
+		Where("transaction.block_id >= COALESCE((?), 0)", lastBlock).
+		Order("transaction.block_id DESC", "transaction.id DESC").
+		Select()
+
+	return transactions, err
+}
+
+// Get the latest slashes of validator before the block, summed by blocks and coins,
+// at least limit rows and all rows of the block of the last one
+func (repository Repository) GetSlashes(publicKey string, beforeBlock *uint64, limit int) ([]Slash, error) {
+	var slashes []Slash
+
+	_, err := repository.db.Query(&slashes, `
+		WITH sums AS (
+			SELECT s.block_id, b.created_at, c.symbol AS coin, SUM(s.amount) AS amount, COUNT(*) AS delegators
+			FROM slashes AS s
+			INNER JOIN blocks AS b ON b.id = s.block_id
+			INNER JOIN coins AS c ON c.id = s.coin_id
+			WHERE s.validator_id = (SELECT id FROM validators WHERE public_key = ?0)
+				AND (?1::bigint IS NULL OR s.block_id < ?1)
+			GROUP BY s.block_id, b.created_at, c.symbol
+		)
+		SELECT * FROM sums
+		WHERE block_id >= COALESCE((SELECT block_id FROM sums ORDER BY block_id DESC, coin OFFSET ?2 LIMIT 1), 0)
+		ORDER BY block_id DESC, coin`,
+		publicKey, beforeBlock, limit-1)
+
+	return slashes, err
+}
+
+// Get the latest validator set entries and exits before the block, one per block,
+// block ranges of the validator blocks are scanned from the latest until limit changes are found,
+// so the cost of a page does not grow with the chain height
+func (repository Repository) GetSetChanges(publicKey string, beforeBlock *uint64, limit int) ([]SetChange, error) {
+	var first, last *uint64
+
+	_, err := repository.db.QueryOne(pg.Scan(&first, &last), `
+		SELECT MIN(block_id), MAX(block_id) FROM block_validator
+		WHERE validator_id = (SELECT id FROM validators WHERE public_key = ?)`,
+		publicKey)
+	if err != nil || first == nil {
+		return nil, err
+	}
+
+	// the validator exits the set after its last block
+	to := *last + 1
+	if beforeBlock != nil && *beforeBlock <= to {
+		to = *beforeBlock - 1
+	}
+
+	changes := make([]SetChange, 0, limit)
+	for to >= *first && len(changes) < limit {
+		from := *first
+		if to-from >= config.ValidatorSetChangesMaxBlocks {
+			from = to - config.ValidatorSetChangesMaxBlocks + 1
+		}
+
+		rangeChanges, err := repository.getSetChangesInRange(publicKey, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, rangeChanges...)
+		to = from - 1
+	}
+
+	if len(changes) > limit {
+		changes = changes[:limit]
+	}
+
+	return changes, nil
+}
+
+// Get validator set entries and exits at blocks in range [fromBlock, toBlock] from the latest,
+// the validator enters the set at the first block of consecutive blocks with it and exits after the last one
+func (repository Repository) getSetChangesInRange(publicKey string, fromBlock uint64, toBlock uint64) ([]SetChange, error) {
+	var changes []SetChange
+
+	_, err := repository.db.Query(&changes, `
+		WITH ranged AS (
+			SELECT block_id, created_at,
+				LAG(block_id) OVER w AS prev_block_id, LEAD(block_id) OVER w AS next_block_id
+			FROM block_validator
+			WHERE validator_id = (SELECT id FROM validators WHERE public_key = ?0)
+				AND block_id >= ?1 - 1 AND block_id <= ?2
+			WINDOW w AS (ORDER BY block_id)
+		), changes AS (
+			SELECT block_id, created_at, TRUE AS entered FROM ranged
+			WHERE block_id >= ?1 AND prev_block_id IS DISTINCT FROM block_id - 1
+			UNION ALL
+			SELECT b.id, b.created_at, FALSE FROM ranged AS r INNER JOIN blocks AS b ON b.id = r.block_id + 1
+			WHERE r.block_id + 1 >= ?1 AND r.block_id + 1 <= ?2 AND r.next_block_id IS DISTINCT FROM r.block_id + 1
+		)
+		SELECT * FROM changes
+		ORDER BY block_id DESC`,
+		publicKey, fromBlock, toBlock)
+
+	return changes, err
+}
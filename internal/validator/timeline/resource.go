@@ -0,0 +1,106 @@
+package timeline
+
+import (
+	"sort"
+	"time"
+
+	"github.com/noah-blockchain/coinExplorer-tools/models"
+	"github.com/noah-blockchain/noah-explorer-api/internal/helpers"
+	"github.com/noah-blockchain/noah-explorer-api/internal/resource"
+	"github.com/noah-blockchain/noah-explorer-api/internal/transaction"
+)
+
+const (
+	TypeDeclareCandidacy    = "declare_candidacy"
+	TypeEditCandidate       = "edit_candidate"
+	TypeSetCandidateOnline  = "set_candidate_online"
+	TypeSetCandidateOffline = "set_candidate_offline"
+	TypeDelegate            = "delegate"
+	TypeUnbond              = "unbond"
+	TypeSlash               = "slash"
+	TypeValidatorSetEntry   = "validator_set_entry"
+	TypeValidatorSetExit    = "validator_set_exit"
+)
+
+var txEntryTypes = map[uint8]string{
+	models.TxTypeDeclareCandidacy:    TypeDeclareCandidacy,
+	models.TxTypeEditCandidate:       TypeEditCandidate,
+	models.TxTypeSetCandidateOnline:  TypeSetCandidateOnline,
+	models.TxTypeSetCandidateOffline: TypeSetCandidateOffline,
+	models.TxTypeDelegate:            TypeDelegate,
+	models.TxTypeUnbound:             TypeUnbond,
+}
+
+type Resource struct {
+	Type      string                 `json:"type"`
+	Block     uint64                 `json:"block"`
+	Timestamp string                 `json:"timestamp"`
+	Data      resource.ItemInterface `json:"data"`
+}
+
+type SlashResource struct {
+	Coin       string `json:"coin"`
+	Amount     string `json:"amount"`
+	Delegators uint64 `json:"delegators"`
+}
+
+// Entry of validator timeline
+type Entry struct {
+	Type      string
+	BlockID   uint64
+	CreatedAt time.Time
+	Data      resource.ItemInterface
+}
+
+func (Resource) Transform(model resource.ItemInterface, params ...resource.ParamInterface) resource.Interface {
+	entry := model.(Entry)
+
+	return Resource{
+		Type:      entry.Type,
+		Block:     entry.BlockID,
+		Timestamp: entry.CreatedAt.Format(time.RFC3339),
+		Data:      entry.Data,
+	}
+}
+
+// Merge transactions, slashes and validator set changes into entries from the latest,
+// validator set changes go first as they are caused by the previous blocks
+func Merge(txs []models.Transaction, slashes []Slash, changes []SetChange) []Entry {
+	entries := make([]Entry, 0, len(txs)+len(slashes)+len(changes))
+	for _, change := range changes {
+		entryType := TypeValidatorSetExit
+		if change.Entered {
+			entryType = TypeValidatorSetEntry
+		}
+
+		entries = append(entries, Entry{Type: entryType, BlockID: change.BlockID, CreatedAt: change.CreatedAt})
+	}
+
+	for _, slash := range slashes {
+		entries = append(entries, Entry{
+			Type:      TypeSlash,
+			BlockID:   slash.BlockID,
+			CreatedAt: slash.CreatedAt,
+			Data: SlashResource{
+				Coin:       slash.Coin,
+				Amount:     helpers.QNoahStr2Noah(slash.Amount),
+				Delegators: slash.Delegators,
+			},
+		})
+	}
+
+	for _, tx := range txs {
+		entries = append(entries, Entry{
+			Type:      txEntryTypes[tx.Type],
+			BlockID:   tx.BlockID,
+			CreatedAt: tx.CreatedAt,
+			Data:      new(transaction.Resource).Transform(tx),
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].BlockID > entries[j].BlockID
+	})
+
+	return entries
+}
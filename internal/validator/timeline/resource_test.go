@@ -0,0 +1,18 @@
+package timeline
+
+import "testing"
+
+func TestMergeOrdersByBlock(t *testing.T) {
+	merged := Merge(nil, []Slash{{BlockID: 5, Amount: "1"}}, []SetChange{{BlockID: 7, Entered: true}, {BlockID: 5}})
+
+	expected := []string{TypeValidatorSetEntry, TypeValidatorSetExit, TypeSlash}
+	if len(merged) != len(expected) {
+		t.Fatalf("expected %d entries, got %d", len(expected), len(merged))
+	}
+
+	for i, entry := range merged {
+		if entry.Type != expected[i] {
+			t.Fatalf("expected %s at %d, got %s", expected[i], i, entry.Type)
+		}
+	}
+}